@@ -3,6 +3,7 @@ package config
 import (
 	"errors"
 	"os"
+	"regexp"
 
 	"gopkg.in/yaml.v3"
 )
@@ -12,9 +13,94 @@ type Credential struct {
 	Password string `yaml:"password"`
 }
 
+type Collector struct {
+	Enabled bool `yaml:"enabled"`
+}
+
+// PerUserCollector is a collector that can additionally export a series per
+// active user session, which can be thousands on a BRAS or hotspot.
+type PerUserCollector struct {
+	Collector `yaml:",inline"`
+	PerUser   bool `yaml:"per_user"`
+}
+
+type QueueCollector struct {
+	Collector `yaml:",inline"`
+	Include   Regexp `yaml:"include"`
+	Exclude   Regexp `yaml:"exclude"`
+}
+
+// Matches reports whether a queue with the given name should be exported.
+func (q QueueCollector) Matches(name string) bool {
+	if q.Include.Regexp != nil && !q.Include.MatchString(name) {
+		return false
+	}
+	if q.Exclude.Regexp != nil && q.Exclude.MatchString(name) {
+		return false
+	}
+	return true
+}
+
+type UpdateCollector struct {
+	Collector `yaml:",inline"`
+	// CheckForUpdates makes the router contact the MikroTik update servers
+	// on every probe. Otherwise the result of the last check is used.
+	CheckForUpdates bool `yaml:"check_for_updates"`
+}
+
+// Regexp is a regular expression which is compiled when the configuration is
+// loaded.
+type Regexp struct {
+	*regexp.Regexp
+}
+
+func (r *Regexp) UnmarshalYAML(value *yaml.Node) error {
+	var expression string
+	if err := value.Decode(&expression); err != nil {
+		return err
+	}
+
+	compiled, err := regexp.Compile(expression)
+	if err != nil {
+		return err
+	}
+
+	r.Regexp = compiled
+	return nil
+}
+
+// Collectors holds the optional collectors. Health, interface and resource
+// metrics are always collected, everything else has to be enabled.
+type Collectors struct {
+	Route       Collector        `yaml:"route"`
+	Ipsec       Collector        `yaml:"ipsec"`
+	Wireguard   Collector        `yaml:"wireguard"`
+	Ppp         PerUserCollector `yaml:"ppp"`
+	Queue       QueueCollector   `yaml:"queue"`
+	Netwatch    Collector        `yaml:"netwatch"`
+	Poe         Collector        `yaml:"poe"`
+	Cpu         Collector        `yaml:"cpu"`
+	Update      UpdateCollector  `yaml:"update"`
+	Certificate Collector        `yaml:"certificate"`
+	Ntp         Collector        `yaml:"ntp"`
+	Disk        Collector        `yaml:"disk"`
+	Container   Collector        `yaml:"container"`
+	Lte         Collector        `yaml:"lte"`
+	Vrrp        Collector        `yaml:"vrrp"`
+	Bridge      Collector        `yaml:"bridge"`
+	Neighbor    Collector        `yaml:"neighbor"`
+	Dns         Collector        `yaml:"dns"`
+	Hotspot     PerUserCollector `yaml:"hotspot"`
+	Mpls        Collector        `yaml:"mpls"`
+	Switch      Collector        `yaml:"switch"`
+	User        PerUserCollector `yaml:"user"`
+	Scheduler   Collector        `yaml:"scheduler"`
+}
+
 type Configuration struct {
 	Timeout     float64
 	Credentials map[string]Credential `yaml:"credentials"`
+	Collectors  Collectors            `yaml:"collectors"`
 }
 
 func NewConfiguration(configFilePath string) (Configuration, error) {
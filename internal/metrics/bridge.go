@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func setBridgeMetrics(client mikrotik.Client, registry *prometheus.Registry) error {
+	ports, err := client.GetBridgePorts()
+	if err != nil {
+		return err
+	}
+
+	forwardingMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_bridge_port_forwarding",
+		Help: "Whether the bridge port is forwarding traffic",
+	}, []string{"bridge", "interface"})
+	registry.MustRegister(forwardingMetric)
+
+	learningMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_bridge_port_learning",
+		Help: "Whether the bridge port is learning MAC addresses",
+	}, []string{"bridge", "interface"})
+	registry.MustRegister(learningMetric)
+
+	infoMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_bridge_port_info",
+		Help: "Spanning tree role and status of the bridge port",
+	}, []string{"bridge", "interface", "role", "status", "edge_port"})
+	registry.MustRegister(infoMetric)
+
+	for _, port := range ports {
+		if port.Disabled {
+			continue
+		}
+
+		forwardingMetric.WithLabelValues(port.Bridge, port.Interface).Set(boolToFloat64(port.Forwarding))
+		learningMetric.WithLabelValues(port.Bridge, port.Interface).Set(boolToFloat64(port.Learning))
+		infoMetric.WithLabelValues(port.Bridge, port.Interface, port.Role, port.Status, strconv.FormatBool(port.EdgePort)).Set(1)
+	}
+
+	hosts, err := client.GetBridgeHosts()
+	if err != nil {
+		return err
+	}
+
+	hostsMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_bridge_hosts",
+		Help: "Number of entries in the bridge host table",
+	}, []string{"bridge", "interface", "type"})
+	registry.MustRegister(hostsMetric)
+
+	for _, host := range hosts {
+		hostsMetric.WithLabelValues(host.Bridge, host.OnInterface, host.Type()).Inc()
+	}
+
+	return nil
+}
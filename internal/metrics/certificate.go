@@ -0,0 +1,53 @@
+package metrics
+
+import (
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func setCertificateMetrics(client mikrotik.Client, registry *prometheus.Registry) error {
+	certificates, err := client.GetCertificates()
+	if err != nil {
+		return err
+	}
+
+	labels := []string{"name", "common_name"}
+
+	notBeforeMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_certificate_not_before_timestamp_seconds",
+		Help: "Unix timestamp from which the certificate is valid",
+	}, labels)
+	registry.MustRegister(notBeforeMetric)
+
+	notAfterMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_certificate_not_after_timestamp_seconds",
+		Help: "Unix timestamp at which the certificate expires",
+	}, labels)
+	registry.MustRegister(notAfterMetric)
+
+	trustedMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_certificate_trusted",
+		Help: "Whether the certificate is trusted",
+	}, labels)
+	registry.MustRegister(trustedMetric)
+
+	validMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_certificate_valid",
+		Help: "Whether the certificate is neither expired, revoked nor invalid",
+	}, labels)
+	registry.MustRegister(validMetric)
+
+	for _, certificate := range certificates {
+		if !certificate.NotBefore.IsZero() {
+			notBeforeMetric.WithLabelValues(certificate.Name, certificate.CommonName).Set(float64(certificate.NotBefore.Unix()))
+		}
+		if !certificate.NotAfter.IsZero() {
+			notAfterMetric.WithLabelValues(certificate.Name, certificate.CommonName).Set(float64(certificate.NotAfter.Unix()))
+		}
+
+		trustedMetric.WithLabelValues(certificate.Name, certificate.CommonName).Set(boolToFloat64(certificate.Trusted))
+		validMetric.WithLabelValues(certificate.Name, certificate.CommonName).Set(boolToFloat64(certificate.IsValid()))
+	}
+
+	return nil
+}
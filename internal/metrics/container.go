@@ -0,0 +1,58 @@
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func setContainerMetrics(client mikrotik.Client, registry *prometheus.Registry) error {
+	containers, err := client.GetContainers()
+	if err != nil {
+		return err
+	}
+
+	runningMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_container_running",
+		Help: "Whether the container is running",
+	}, []string{"name", "tag", "status"})
+	registry.MustRegister(runningMetric)
+
+	infoMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_container_info",
+		Help: "Configuration of the container",
+	}, []string{"name", "tag", "interface", "root_dir", "start_on_boot", "comment"})
+	registry.MustRegister(infoMetric)
+
+	for _, container := range containers {
+		runningMetric.WithLabelValues(container.DisplayName(), container.Tag, container.Status).Set(boolToFloat64(container.IsRunning()))
+		infoMetric.WithLabelValues(
+			container.DisplayName(),
+			container.Tag,
+			container.Interface,
+			container.RootDir,
+			strconv.FormatBool(container.StartOnBoot),
+			container.Comment,
+		).Set(1)
+	}
+
+	config, err := client.GetContainerConfig()
+	if err != nil {
+		return err
+	}
+
+	// Without the container package there is no configuration to export.
+	if config == (mikrotik.ContainerConfig{}) {
+		return nil
+	}
+
+	configInfoMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_container_config_info",
+		Help: "Global container configuration",
+	}, []string{"registry_url", "tmpdir", "ram_high"})
+	registry.MustRegister(configInfoMetric)
+	configInfoMetric.WithLabelValues(config.RegistryUrl, config.Tmpdir, config.RamHigh).Set(1)
+
+	return nil
+}
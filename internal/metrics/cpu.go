@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func setCpuMetrics(client mikrotik.Client, registry *prometheus.Registry) error {
+	cpus, err := client.GetCpus()
+	if err != nil {
+		return err
+	}
+
+	loadMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_system_resource_cpu_core_load",
+		Help: "Percentage of used resources of a single CPU",
+	}, []string{"cpu"})
+	registry.MustRegister(loadMetric)
+
+	irqMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_system_resource_cpu_core_irq",
+		Help: "Percentage of a single CPU spent handling interrupts",
+	}, []string{"cpu"})
+	registry.MustRegister(irqMetric)
+
+	diskMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_system_resource_cpu_core_disk",
+		Help: "Percentage of a single CPU spent waiting for the disk",
+	}, []string{"cpu"})
+	registry.MustRegister(diskMetric)
+
+	for _, cpu := range cpus {
+		loadMetric.WithLabelValues(cpu.Cpu).Set(cpu.Load)
+		irqMetric.WithLabelValues(cpu.Cpu).Set(cpu.Irq)
+		diskMetric.WithLabelValues(cpu.Cpu).Set(cpu.Disk)
+	}
+
+	irqs, err := client.GetIrqs()
+	if err != nil {
+		return err
+	}
+
+	irqCountMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mikrotik_system_resource_irq_count",
+		Help: "Number of interrupts handled",
+	}, []string{"irq", "users"})
+	registry.MustRegister(irqCountMetric)
+
+	irqInfoMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_system_resource_irq_info",
+		Help: "Information about an IRQ and the CPU currently handling it",
+	}, []string{"irq", "users", "cpu", "active_cpu"})
+	registry.MustRegister(irqInfoMetric)
+
+	for _, irq := range irqs {
+		irqCountMetric.WithLabelValues(irq.Irq, irq.Users).Add(irq.Count)
+		irqInfoMetric.WithLabelValues(irq.Irq, irq.Users, irq.Cpu, irq.ActiveCpu).Set(1)
+	}
+
+	return nil
+}
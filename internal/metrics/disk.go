@@ -0,0 +1,70 @@
+package metrics
+
+import (
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func setDiskMetrics(client mikrotik.Client, registry *prometheus.Registry) error {
+	disks, err := client.GetDisks()
+	if err != nil {
+		return err
+	}
+
+	labels := []string{"slot", "type", "fs"}
+
+	sizeMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_disk_size_bytes",
+		Help: "Size of the disk in bytes",
+	}, labels)
+	registry.MustRegister(sizeMetric)
+
+	freeMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_disk_free_bytes",
+		Help: "Free space on the disk filesystem in bytes",
+	}, labels)
+	registry.MustRegister(freeMetric)
+
+	infoMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_disk_info",
+		Help: "Model, serial and RAID membership of the disk",
+	}, append(labels, "model", "serial", "raid_master"))
+	registry.MustRegister(infoMetric)
+
+	raidStateMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_disk_raid_state",
+		Help: "State of the RAID array, always 1",
+	}, []string{"slot", "raid_type", "state"})
+	registry.MustRegister(raidStateMetric)
+
+	raidMembersMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_disk_raid_members",
+		Help: "Number of disks that are members of the RAID array",
+	}, []string{"slot", "raid_type"})
+	registry.MustRegister(raidMembersMetric)
+
+	members := mikrotik.RaidMembers(disks)
+
+	for _, disk := range disks {
+		if disk.Disabled {
+			continue
+		}
+
+		if disk.Size != nil {
+			sizeMetric.WithLabelValues(disk.Slot, disk.Type, disk.Fs).Set(*disk.Size)
+		}
+		if disk.Free != nil {
+			freeMetric.WithLabelValues(disk.Slot, disk.Type, disk.Fs).Set(*disk.Free)
+		}
+		infoMetric.WithLabelValues(disk.Slot, disk.Type, disk.Fs, disk.Model, disk.Serial, disk.RaidMaster).Set(1)
+
+		if disk.IsRaid() {
+			raidMembersMetric.WithLabelValues(disk.Slot, disk.RaidType).Set(members[disk.Slot])
+			if disk.RaidState != "" {
+				raidStateMetric.WithLabelValues(disk.Slot, disk.RaidType, disk.RaidState).Set(1)
+			}
+		}
+	}
+
+	return nil
+}
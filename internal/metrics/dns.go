@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func setDnsMetrics(client mikrotik.Client, registry *prometheus.Registry) error {
+	dns, err := client.GetDns()
+	if err != nil {
+		return err
+	}
+
+	// Cache sizes that are not reported are skipped.
+	if dns.CacheSize != "" {
+		cacheSize, err := mikrotik.ParseKibibytes(dns.CacheSize)
+		if err != nil {
+			return err
+		}
+
+		cacheSizeMetric := prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "mikrotik_dns_cache_size_bytes",
+			Help: "Maximum size of the DNS cache in bytes",
+		})
+		registry.MustRegister(cacheSizeMetric)
+		cacheSizeMetric.Set(cacheSize)
+	}
+
+	if dns.CacheUsed != "" {
+		cacheUsed, err := mikrotik.ParseKibibytes(dns.CacheUsed)
+		if err != nil {
+			return err
+		}
+
+		cacheUsedMetric := prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "mikrotik_dns_cache_used_bytes",
+			Help: "Used size of the DNS cache in bytes",
+		})
+		registry.MustRegister(cacheUsedMetric)
+		cacheUsedMetric.Set(cacheUsed)
+	}
+
+	allowRemoteRequestsMetric := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "mikrotik_dns_allow_remote_requests",
+		Help: "Whether the router answers DNS requests from the network",
+	})
+	registry.MustRegister(allowRemoteRequestsMetric)
+	allowRemoteRequestsMetric.Set(boolToFloat64(dns.AllowRemoteRequests))
+
+	dohEnabledMetric := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "mikrotik_dns_doh_enabled",
+		Help: "Whether a DNS over HTTPS server is used",
+	})
+	registry.MustRegister(dohEnabledMetric)
+	dohEnabledMetric.Set(boolToFloat64(dns.IsDohEnabled()))
+
+	entries, err := client.GetDnsCache()
+	if err != nil {
+		return err
+	}
+
+	cacheEntriesMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_dns_cache_entries",
+		Help: "Number of DNS cache entries per record type",
+	}, []string{"type"})
+	registry.MustRegister(cacheEntriesMetric)
+
+	for _, entry := range entries {
+		cacheEntriesMetric.WithLabelValues(entry.Type).Inc()
+	}
+
+	return nil
+}
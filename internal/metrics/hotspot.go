@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/config"
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func setHotspotMetrics(client mikrotik.Client, registry *prometheus.Registry, collector config.PerUserCollector) error {
+	hotspot, err := client.GetHotspot()
+	if err != nil {
+		return err
+	}
+
+	activeUsersMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_hotspot_active_users",
+		Help: "Number of active hotspot users per server",
+	}, []string{"server"})
+	registry.MustRegister(activeUsersMetric)
+
+	hostsMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_hotspot_hosts",
+		Help: "Number of hosts seen by the hotspot server",
+	}, []string{"server", "authorized"})
+	registry.MustRegister(hostsMetric)
+
+	for _, active := range hotspot.Active {
+		activeUsersMetric.WithLabelValues(active.Server).Inc()
+	}
+
+	for _, host := range hotspot.Hosts {
+		hostsMetric.WithLabelValues(host.Server, strconv.FormatBool(host.Authorized)).Inc()
+	}
+
+	if !collector.PerUser {
+		return nil
+	}
+
+	userLabels := []string{"server", "user", "address", "mac_address"}
+
+	receivedBytesMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mikrotik_hotspot_user_received_bytes",
+		Help: "Number of bytes received from the hotspot user",
+	}, userLabels)
+	registry.MustRegister(receivedBytesMetric)
+
+	transferredBytesMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mikrotik_hotspot_user_transferred_bytes",
+		Help: "Number of bytes transmitted to the hotspot user",
+	}, userLabels)
+	registry.MustRegister(transferredBytesMetric)
+
+	uptimeMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_hotspot_user_uptime_seconds",
+		Help: "Time since the hotspot user logged in",
+	}, userLabels)
+	registry.MustRegister(uptimeMetric)
+
+	sessionTimeLeftMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_hotspot_user_session_time_left_seconds",
+		Help: "Time until the session of the hotspot user ends, omitted if unlimited",
+	}, userLabels)
+	registry.MustRegister(sessionTimeLeftMetric)
+
+	for _, active := range hotspot.Active {
+		labels := []string{active.Server, active.User, active.Address, active.MacAddress}
+
+		receivedBytesMetric.WithLabelValues(labels...).Add(active.BytesIn)
+		transferredBytesMetric.WithLabelValues(labels...).Add(active.BytesOut)
+		uptimeMetric.WithLabelValues(labels...).Set(float64(active.Uptime))
+		if active.SessionTimeLeft != nil {
+			sessionTimeLeftMetric.WithLabelValues(labels...).Set(float64(*active.SessionTimeLeft))
+		}
+	}
+
+	return nil
+}
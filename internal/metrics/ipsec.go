@@ -0,0 +1,93 @@
+package metrics
+
+import (
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func setIpsecMetrics(client mikrotik.Client, registry *prometheus.Registry) error {
+	ipsec, err := client.GetIpsec()
+	if err != nil {
+		return err
+	}
+
+	peerLabels := []string{"peer", "local_address", "remote_address"}
+
+	peerEstablishedMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_ipsec_peer_established",
+		Help: "Whether the IPsec peer is in the established state",
+	}, append(peerLabels, "state", "side"))
+	registry.MustRegister(peerEstablishedMetric)
+
+	peerUptimeMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_ipsec_peer_uptime_seconds",
+		Help: "Time since the IPsec peer was established",
+	}, peerLabels)
+	registry.MustRegister(peerUptimeMetric)
+
+	peerReceivedBytesMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mikrotik_ipsec_peer_received_bytes",
+		Help: "Number of bytes received from the IPsec peer",
+	}, peerLabels)
+	registry.MustRegister(peerReceivedBytesMetric)
+
+	peerReceivedPacketsMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mikrotik_ipsec_peer_received_packets",
+		Help: "Number of packets received from the IPsec peer",
+	}, peerLabels)
+	registry.MustRegister(peerReceivedPacketsMetric)
+
+	peerTransferredBytesMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mikrotik_ipsec_peer_transferred_bytes",
+		Help: "Number of bytes transmitted to the IPsec peer",
+	}, peerLabels)
+	registry.MustRegister(peerTransferredBytesMetric)
+
+	peerTransferredPacketsMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mikrotik_ipsec_peer_transferred_packets",
+		Help: "Number of packets transmitted to the IPsec peer",
+	}, peerLabels)
+	registry.MustRegister(peerTransferredPacketsMetric)
+
+	peerInstalledSAsMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_ipsec_peer_installed_sa",
+		Help: "Number of installed security associations with the IPsec peer",
+	}, peerLabels)
+	registry.MustRegister(peerInstalledSAsMetric)
+
+	for _, peer := range ipsec.Peers {
+		labels := []string{peer.Peer, peer.LocalAddress, peer.RemoteAddress}
+
+		peerEstablishedMetric.WithLabelValues(append(labels, peer.State, peer.Side)...).Set(boolToFloat64(peer.IsEstablished()))
+		peerUptimeMetric.WithLabelValues(labels...).Set(float64(peer.Uptime))
+		peerReceivedBytesMetric.WithLabelValues(labels...).Add(peer.RxByte)
+		peerReceivedPacketsMetric.WithLabelValues(labels...).Add(peer.RxPacket)
+		peerTransferredBytesMetric.WithLabelValues(labels...).Add(peer.TxByte)
+		peerTransferredPacketsMetric.WithLabelValues(labels...).Add(peer.TxPacket)
+		peerInstalledSAsMetric.WithLabelValues(labels...).Set(ipsec.CountInstalledSAs(peer))
+	}
+
+	installedSAsMetric := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "mikrotik_ipsec_installed_sa",
+		Help: "Total number of installed security associations",
+	})
+	registry.MustRegister(installedSAsMetric)
+	installedSAsMetric.Set(float64(len(ipsec.InstalledSAs)))
+
+	policyActiveMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_ipsec_policy_active",
+		Help: "Whether the IPsec policy is active with an established phase 2",
+	}, []string{"peer", "src_address", "dst_address", "comment", "ph2_state"})
+	registry.MustRegister(policyActiveMetric)
+
+	for _, policy := range ipsec.Policies {
+		if policy.Disabled || policy.Template {
+			continue
+		}
+
+		active := policy.Active && policy.Ph2State == "established"
+		policyActiveMetric.WithLabelValues(policy.Peer, policy.SrcAddress, policy.DstAddress, policy.Comment, policy.Ph2State).Set(boolToFloat64(active))
+	}
+
+	return nil
+}
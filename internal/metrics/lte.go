@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func setLteMetrics(client mikrotik.Client, registry *prometheus.Registry) error {
+	monitors, err := client.GetLteMonitors()
+	if err != nil {
+		return err
+	}
+
+	rsrpMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_lte_rsrp_dbm",
+		Help: "Reference signal received power",
+	}, []string{"interface"})
+	registry.MustRegister(rsrpMetric)
+
+	rsrqMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_lte_rsrq_db",
+		Help: "Reference signal received quality",
+	}, []string{"interface"})
+	registry.MustRegister(rsrqMetric)
+
+	sinrMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_lte_sinr_db",
+		Help: "Signal to interference plus noise ratio",
+	}, []string{"interface"})
+	registry.MustRegister(sinrMetric)
+
+	rssiMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_lte_rssi_dbm",
+		Help: "Received signal strength indicator",
+	}, []string{"interface"})
+	registry.MustRegister(rssiMetric)
+
+	cqiMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_lte_cqi",
+		Help: "Channel quality indicator",
+	}, []string{"interface"})
+	registry.MustRegister(cqiMetric)
+
+	cellIdMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_lte_cell_id",
+		Help: "Identifier of the serving cell",
+	}, []string{"interface"})
+	registry.MustRegister(cellIdMetric)
+
+	infoMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_lte_info",
+		Help: "Operator, access technology, band and cell of the LTE interface",
+	}, []string{"interface", "operator", "access_technology", "band", "cell_id"})
+	registry.MustRegister(infoMetric)
+
+	registrationMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_lte_registration_state",
+		Help: "Registration state of the LTE interface, 1 for the current state",
+	}, []string{"interface", "state"})
+	registry.MustRegister(registrationMetric)
+
+	for _, monitor := range monitors {
+		for metric, value := range map[*prometheus.GaugeVec]string{
+			rsrpMetric:   monitor.Rsrp,
+			rsrqMetric:   monitor.Rsrq,
+			sinrMetric:   monitor.Sinr,
+			rssiMetric:   monitor.Rssi,
+			cqiMetric:    monitor.Cqi,
+			cellIdMetric: monitor.CurrentCellid,
+		} {
+			if number, ok := mikrotik.ParseSignal(value); ok {
+				metric.WithLabelValues(monitor.Interface).Set(number)
+			}
+		}
+
+		infoMetric.WithLabelValues(monitor.Interface, monitor.CurrentOperator, monitor.AccessTechnology, monitor.Band(), monitor.CurrentCellid).Set(1)
+
+		known := false
+		for _, state := range mikrotik.LteRegistrationStatuses {
+			registrationMetric.WithLabelValues(monitor.Interface, state).Set(boolToFloat64(state == monitor.RegistrationStatus))
+			known = known || state == monitor.RegistrationStatus
+		}
+		if !known && monitor.RegistrationStatus != "" {
+			registrationMetric.WithLabelValues(monitor.Interface, monitor.RegistrationStatus).Set(1)
+		}
+	}
+
+	return nil
+}
@@ -1,8 +1,10 @@
 package metrics
 
 import (
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/config"
 	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
 	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
 )
 
 var (
@@ -12,7 +14,7 @@ var (
 	})
 )
 
-func CreateRegistryWithMetrics(client mikrotik.Client) (*prometheus.Registry, error) {
+func CreateRegistryWithMetrics(client mikrotik.Client, collectors config.Collectors) (*prometheus.Registry, error) {
 	registry := prometheus.NewRegistry()
 	registry.MustRegister(probeMetric)
 
@@ -28,6 +30,56 @@ func CreateRegistryWithMetrics(client mikrotik.Client) (*prometheus.Registry, er
 		return createDefaultErrorRegistry(), err
 	}
 
+	collectorSuccessMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_collector_success",
+		Help: "Whether the optional collector was successful",
+	}, []string{"collector"})
+	registry.MustRegister(collectorSuccessMetric)
+
+	// Optional collectors only mark themselves as failed, so that a menu
+	// missing on some devices does not fail the probe for them.
+	for _, c := range []struct {
+		name    string
+		enabled bool
+		set     func() error
+	}{
+		{"route", collectors.Route.Enabled, func() error { return setRouteMetrics(client, registry) }},
+		{"ipsec", collectors.Ipsec.Enabled, func() error { return setIpsecMetrics(client, registry) }},
+		{"wireguard", collectors.Wireguard.Enabled, func() error { return setWireguardMetrics(client, registry) }},
+		{"ppp", collectors.Ppp.Enabled, func() error { return setPppMetrics(client, registry, collectors.Ppp) }},
+		{"queue", collectors.Queue.Enabled, func() error { return setQueueMetrics(client, registry, collectors.Queue) }},
+		{"netwatch", collectors.Netwatch.Enabled, func() error { return setNetwatchMetrics(client, registry) }},
+		{"poe", collectors.Poe.Enabled, func() error { return setPoeMetrics(client, registry) }},
+		{"cpu", collectors.Cpu.Enabled, func() error { return setCpuMetrics(client, registry) }},
+		{"update", collectors.Update.Enabled, func() error { return setUpdateMetrics(client, registry, collectors.Update) }},
+		{"certificate", collectors.Certificate.Enabled, func() error { return setCertificateMetrics(client, registry) }},
+		{"ntp", collectors.Ntp.Enabled, func() error { return setNtpMetrics(client, registry) }},
+		{"disk", collectors.Disk.Enabled, func() error { return setDiskMetrics(client, registry) }},
+		{"container", collectors.Container.Enabled, func() error { return setContainerMetrics(client, registry) }},
+		{"lte", collectors.Lte.Enabled, func() error { return setLteMetrics(client, registry) }},
+		{"vrrp", collectors.Vrrp.Enabled, func() error { return setVrrpMetrics(client, registry) }},
+		{"bridge", collectors.Bridge.Enabled, func() error { return setBridgeMetrics(client, registry) }},
+		{"neighbor", collectors.Neighbor.Enabled, func() error { return setNeighborMetrics(client, registry) }},
+		{"dns", collectors.Dns.Enabled, func() error { return setDnsMetrics(client, registry) }},
+		{"hotspot", collectors.Hotspot.Enabled, func() error { return setHotspotMetrics(client, registry, collectors.Hotspot) }},
+		{"mpls", collectors.Mpls.Enabled, func() error { return setMplsMetrics(client, registry) }},
+		{"switch", collectors.Switch.Enabled, func() error { return setSwitchMetrics(client, registry) }},
+		{"user", collectors.User.Enabled, func() error { return setUserMetrics(client, registry, collectors.User) }},
+		{"scheduler", collectors.Scheduler.Enabled, func() error { return setSchedulerMetrics(client, registry) }},
+	} {
+		if !c.enabled {
+			continue
+		}
+
+		if err := c.set(); err != nil {
+			log.WithField("collector", c.name).WithError(err).Warn("collector failed")
+			collectorSuccessMetric.WithLabelValues(c.name).Set(0)
+			continue
+		}
+		collectorSuccessMetric.WithLabelValues(c.name).Set(1)
+	}
+
+	probeMetric.Set(1)
 	return registry, nil
 }
 
@@ -58,6 +110,40 @@ func setHealthMetrics(client mikrotik.Client, registry *prometheus.Registry) err
 	registry.MustRegister(voltageMetric)
 	voltageMetric.Set(healthResult.Voltage)
 
+	sensorMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_system_health_sensor",
+		Help: "System Health sensor value. States are reported as 1 for ok and 0 for failure.",
+	}, []string{"name", "unit"})
+	registry.MustRegister(sensorMetric)
+
+	fanSpeedMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_system_health_fan_speed_rpm",
+		Help: "System Health fan speed in RPM",
+	}, []string{"name"})
+	registry.MustRegister(fanSpeedMetric)
+
+	psuStateMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_system_health_psu_ok",
+		Help: "Whether the power supply reports an ok state",
+	}, []string{"name"})
+	registry.MustRegister(psuStateMetric)
+
+	for _, sensor := range healthResult.Sensors {
+		if sensor.Numeric {
+			sensorMetric.WithLabelValues(sensor.Name, sensor.Unit).Set(sensor.Value)
+		} else if value, ok := sensor.StateValue(); ok {
+			sensorMetric.WithLabelValues(sensor.Name, sensor.Unit).Set(value)
+		}
+
+		if sensor.IsFanSpeed() && sensor.Numeric {
+			fanSpeedMetric.WithLabelValues(sensor.Name).Set(sensor.Value)
+		}
+
+		if sensor.IsPsuState() {
+			psuStateMetric.WithLabelValues(sensor.Name).Set(boolToFloat64(sensor.State == "ok"))
+		}
+	}
+
 	return nil
 }
 
@@ -208,6 +294,58 @@ func setResourceMetrics(client mikrotik.Client, registry *prometheus.Registry) e
 	registry.MustRegister(writeSectorsTotalMetric)
 	writeSectorsTotalMetric.Set(resource.WriteSectTotal)
 
-	probeMetric.Set(1)
+	// The uptime and the system info are only exported when available, as
+	// they must not fail the probe for the resource metrics above.
+	if uptime, err := mikrotik.ParseDuration(resource.Uptime); err == nil {
+		uptimeMetric := prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "mikrotik_system_uptime_seconds",
+			Help: "Time since the system was booted",
+		})
+		registry.MustRegister(uptimeMetric)
+		uptimeMetric.Set(uptime)
+	} else {
+		log.WithField("uptime", resource.Uptime).WithError(err).Warn("failed to parse the uptime")
+	}
+
+	if err := setSystemInfoMetric(client, registry, resource); err != nil {
+		log.WithError(err).Warn("failed to query the system info")
+	}
+
+	return nil
+}
+
+func setSystemInfoMetric(client mikrotik.Client, registry *prometheus.Registry, resource mikrotik.Resource) error {
+	identity, err := client.GetIdentity()
+	if err != nil {
+		return err
+	}
+
+	routerboard, err := client.GetRouterboard()
+	if err != nil {
+		return err
+	}
+
+	infoMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_system_info",
+		Help: "Identity, RouterOS version and hardware of the system",
+	}, []string{"identity", "version", "board_name", "architecture", "model", "serial_number", "firmware"})
+	registry.MustRegister(infoMetric)
+	infoMetric.WithLabelValues(
+		identity.Name,
+		resource.Version,
+		resource.BoardName,
+		resource.ArchitectureName,
+		routerboard.Model,
+		routerboard.SerialNumber,
+		routerboard.CurrentFirmware,
+	).Set(1)
+
 	return nil
 }
+
+func boolToFloat64(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
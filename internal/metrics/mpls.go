@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func setMplsMetrics(client mikrotik.Client, registry *prometheus.Registry) error {
+	mpls, err := client.GetMpls()
+	if err != nil {
+		return err
+	}
+
+	ldpNeighborMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_mpls_ldp_neighbor_operational",
+		Help: "Whether the LDP session with the neighbor is operational",
+	}, []string{"peer", "transport", "local_transport"})
+	registry.MustRegister(ldpNeighborMetric)
+
+	for _, neighbor := range mpls.LdpNeighbors {
+		ldpNeighborMetric.WithLabelValues(neighbor.Peer, neighbor.Transport, neighbor.LocalTransport).Set(boolToFloat64(neighbor.Operational))
+	}
+
+	forwardingTableMetric := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "mikrotik_mpls_forwarding_table_entries",
+		Help: "Number of entries in the MPLS forwarding table",
+	})
+	registry.MustRegister(forwardingTableMetric)
+	forwardingTableMetric.Set(mpls.ForwardingTableEntries)
+
+	bfdLabels := []string{"remote_address", "local_address", "interface"}
+
+	bfdStateMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_bfd_session_state",
+		Help: "State of the BFD session, 1 for the current state",
+	}, append(bfdLabels, "state"))
+	registry.MustRegister(bfdStateMetric)
+
+	bfdUptimeMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_bfd_session_uptime_seconds",
+		Help: "Time since the BFD session came up",
+	}, bfdLabels)
+	registry.MustRegister(bfdUptimeMetric)
+
+	bfdMultiplierMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_bfd_session_multiplier",
+		Help: "Number of missed packets after which the BFD session goes down",
+	}, bfdLabels)
+	registry.MustRegister(bfdMultiplierMetric)
+
+	for _, session := range mpls.BfdSessions {
+		labels := []string{session.RemoteAddress, session.LocalAddress, session.Interface}
+
+		for _, state := range mikrotik.BfdStates {
+			bfdStateMetric.WithLabelValues(append(labels, state)...).Set(boolToFloat64(state == session.State))
+		}
+		bfdUptimeMetric.WithLabelValues(labels...).Set(float64(session.Uptime))
+		bfdMultiplierMetric.WithLabelValues(labels...).Set(session.Multiplier)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func setNeighborMetrics(client mikrotik.Client, registry *prometheus.Registry) error {
+	arpEntries, err := client.GetArpEntries()
+	if err != nil {
+		return err
+	}
+
+	arpMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_arp_entries",
+		Help: "Number of ARP entries per interface and status",
+	}, []string{"interface", "status"})
+	registry.MustRegister(arpMetric)
+
+	for _, entry := range arpEntries {
+		arpMetric.WithLabelValues(entry.Interface, entry.State()).Inc()
+	}
+
+	ipv6Neighbors, err := client.GetIpv6Neighbors()
+	if err != nil {
+		return err
+	}
+
+	ipv6NeighborMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_ipv6_neighbor_entries",
+		Help: "Number of IPv6 neighbor entries per interface and status",
+	}, []string{"interface", "status"})
+	registry.MustRegister(ipv6NeighborMetric)
+
+	for _, neighbor := range ipv6Neighbors {
+		ipv6NeighborMetric.WithLabelValues(neighbor.Interface, neighbor.Status).Inc()
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package metrics
+
+import (
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func setNetwatchMetrics(client mikrotik.Client, registry *prometheus.Registry) error {
+	entries, err := client.GetNetwatch()
+	if err != nil {
+		return err
+	}
+
+	labels := []string{"host", "type", "comment"}
+
+	upMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_netwatch_up",
+		Help: "Whether the netwatch host is up",
+	}, labels)
+	registry.MustRegister(upMetric)
+
+	lastChangeMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_netwatch_last_change_timestamp_seconds",
+		Help: "Unix timestamp of the last status change of the netwatch host",
+	}, labels)
+	registry.MustRegister(lastChangeMetric)
+
+	lossMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_netwatch_icmp_loss_percent",
+		Help: "Percentage of lost ICMP probes",
+	}, labels)
+	registry.MustRegister(lossMetric)
+
+	rttMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_netwatch_icmp_rtt_seconds",
+		Help: "Round trip time of the ICMP probes",
+	}, append(labels, "stat"))
+	registry.MustRegister(rttMetric)
+
+	tcpConnectTimeMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_netwatch_tcp_connect_time_seconds",
+		Help: "Time it took to establish the TCP connection",
+	}, labels)
+	registry.MustRegister(tcpConnectTimeMetric)
+
+	httpStatusCodeMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_netwatch_http_status_code",
+		Help: "Status code of the HTTP response",
+	}, labels)
+	registry.MustRegister(httpStatusCodeMetric)
+
+	httpResponseTimeMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_netwatch_http_response_time_seconds",
+		Help: "Time it took to receive the HTTP response",
+	}, labels)
+	registry.MustRegister(httpResponseTimeMetric)
+
+	for _, entry := range entries {
+		if entry.Disabled {
+			continue
+		}
+
+		labels := []string{entry.Host, entry.Type, entry.Comment}
+
+		upMetric.WithLabelValues(labels...).Set(boolToFloat64(entry.IsUp()))
+		if !entry.LastChange.IsZero() {
+			lastChangeMetric.WithLabelValues(labels...).Set(float64(entry.LastChange.Unix()))
+		}
+
+		if entry.LossPercent != nil {
+			lossMetric.WithLabelValues(labels...).Set(*entry.LossPercent)
+		}
+		for stat, rtt := range map[string]*mikrotik.Duration{
+			"avg":    entry.RttAvg,
+			"min":    entry.RttMin,
+			"max":    entry.RttMax,
+			"jitter": entry.RttJitter,
+		} {
+			if rtt != nil {
+				rttMetric.WithLabelValues(append(labels, stat)...).Set(float64(*rtt))
+			}
+		}
+		if entry.TcpConnectTime != nil {
+			tcpConnectTimeMetric.WithLabelValues(labels...).Set(float64(*entry.TcpConnectTime))
+		}
+		if entry.HttpStatusCode != nil {
+			httpStatusCodeMetric.WithLabelValues(labels...).Set(*entry.HttpStatusCode)
+		}
+		if entry.HttpRespTime != nil {
+			httpResponseTimeMetric.WithLabelValues(labels...).Set(float64(*entry.HttpRespTime))
+		}
+	}
+
+	return nil
+}
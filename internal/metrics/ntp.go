@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func setNtpMetrics(client mikrotik.Client, registry *prometheus.Registry) error {
+	clock, err := client.GetClock()
+	if err != nil {
+		return err
+	}
+
+	now, err := clock.Now()
+	if err != nil {
+		return err
+	}
+
+	clockMetric := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "mikrotik_system_clock_timestamp_seconds",
+		Help: "Unix timestamp of the system clock",
+	})
+	registry.MustRegister(clockMetric)
+	clockMetric.Set(float64(now.Unix()))
+
+	ntpClient, err := client.GetNtpClient()
+	if err != nil {
+		return err
+	}
+
+	enabledMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_ntp_client_enabled",
+		Help: "Whether the NTP client is enabled",
+	}, []string{"mode"})
+	registry.MustRegister(enabledMetric)
+	enabledMetric.WithLabelValues(ntpClient.Mode).Set(boolToFloat64(ntpClient.Enabled))
+
+	synchronizedMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_ntp_client_synchronized",
+		Help: "Whether the system clock is synchronized by the NTP client",
+	}, []string{"server", "status"})
+	registry.MustRegister(synchronizedMetric)
+	synchronizedMetric.WithLabelValues(ntpClient.Server(), ntpClient.Status).Set(boolToFloat64(ntpClient.IsSynchronized()))
+
+	if offset, ok := ntpClient.Offset(); ok {
+		offsetMetric := prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "mikrotik_ntp_client_offset_seconds",
+			Help: "Offset of the system clock to the NTP server, the last adjustment for the SNTP client",
+		})
+		registry.MustRegister(offsetMetric)
+		offsetMetric.Set(offset)
+	}
+
+	if stratum, ok := ntpClient.Stratum(); ok {
+		stratumMetric := prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "mikrotik_ntp_client_stratum",
+			Help: "Stratum of the NTP server the system clock is synchronized to",
+		})
+		registry.MustRegister(stratumMetric)
+		stratumMetric.Set(stratum)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func setPoeMetrics(client mikrotik.Client, registry *prometheus.Registry) error {
+	poe, err := client.GetPoe()
+	if err != nil {
+		return err
+	}
+
+	poweredOnMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_poe_out_powered_on",
+		Help: "Whether the port is powering a device",
+	}, []string{"name", "poe_out", "status"})
+	registry.MustRegister(poweredOnMetric)
+
+	voltageMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_poe_out_voltage_volts",
+		Help: "PoE output voltage of the port",
+	}, []string{"name"})
+	registry.MustRegister(voltageMetric)
+
+	currentMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_poe_out_current_amperes",
+		Help: "PoE output current of the port",
+	}, []string{"name"})
+	registry.MustRegister(currentMetric)
+
+	powerMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_poe_out_power_watts",
+		Help: "PoE output power of the port",
+	}, []string{"name"})
+	registry.MustRegister(powerMetric)
+
+	for _, port := range poe.Ports {
+		poweredOnMetric.WithLabelValues(port.Name, port.PoeOut, port.Status).Set(boolToFloat64(port.IsPoweredOn()))
+
+		if port.Voltage != nil {
+			voltageMetric.WithLabelValues(port.Name).Set(*port.Voltage)
+		}
+		if port.Current != nil {
+			currentMetric.WithLabelValues(port.Name).Set(*port.Current / 1000)
+		}
+		if port.Power != nil {
+			powerMetric.WithLabelValues(port.Name).Set(*port.Power)
+		}
+	}
+
+	if poe.Consumption != nil {
+		consumptionMetric := prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "mikrotik_poe_out_consumption_watts",
+			Help: "Total PoE output power of the device",
+		})
+		registry.MustRegister(consumptionMetric)
+		consumptionMetric.Set(*poe.Consumption)
+	}
+
+	if poe.Budget != nil {
+		budgetMetric := prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "mikrotik_poe_out_budget_watts",
+			Help: "Total PoE output power budget of the device",
+		})
+		registry.MustRegister(budgetMetric)
+		budgetMetric.Set(*poe.Budget)
+	}
+
+	return nil
+}
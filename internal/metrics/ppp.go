@@ -0,0 +1,58 @@
+package metrics
+
+import (
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/config"
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func setPppMetrics(client mikrotik.Client, registry *prometheus.Registry, collector config.PerUserCollector) error {
+	ppp, err := client.GetPpp()
+	if err != nil {
+		return err
+	}
+
+	sessionsMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_ppp_active_sessions",
+		Help: "Number of active PPP sessions per service",
+	}, []string{"service"})
+	registry.MustRegister(sessionsMetric)
+
+	profileSessionsMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_ppp_active_sessions_by_profile",
+		Help: "Number of active PPP sessions per profile, empty for sessions without a local secret",
+	}, []string{"profile"})
+	registry.MustRegister(profileSessionsMetric)
+
+	pppoeServerMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_pppoe_server_enabled",
+		Help: "Whether the PPPoE server is enabled",
+	}, []string{"service_name", "interface", "default_profile"})
+	registry.MustRegister(pppoeServerMetric)
+
+	profiles := ppp.Profiles()
+	for _, session := range ppp.Active {
+		sessionsMetric.WithLabelValues(session.Service).Inc()
+		profileSessionsMetric.WithLabelValues(profiles[session.Name]).Inc()
+	}
+
+	for _, server := range ppp.PppoeServers {
+		pppoeServerMetric.WithLabelValues(server.ServiceName, server.Interface, server.DefaultProfile).Set(boolToFloat64(!server.Disabled))
+	}
+
+	if !collector.PerUser {
+		return nil
+	}
+
+	sessionUptimeMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_ppp_session_uptime_seconds",
+		Help: "Time since the PPP session was established",
+	}, []string{"name", "service", "caller_id", "address"})
+	registry.MustRegister(sessionUptimeMetric)
+
+	for _, session := range ppp.Active {
+		sessionUptimeMetric.WithLabelValues(session.Name, session.Service, session.CallerId, session.Address).Set(float64(session.Uptime))
+	}
+
+	return nil
+}
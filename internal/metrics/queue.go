@@ -0,0 +1,143 @@
+package metrics
+
+import (
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/config"
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func setQueueMetrics(client mikrotik.Client, registry *prometheus.Registry, collector config.QueueCollector) error {
+	if err := setSimpleQueueMetrics(client, registry, collector); err != nil {
+		return err
+	}
+
+	return setQueueTreeMetrics(client, registry, collector)
+}
+
+func setSimpleQueueMetrics(client mikrotik.Client, registry *prometheus.Registry, collector config.QueueCollector) error {
+	queues, err := client.GetSimpleQueues()
+	if err != nil {
+		return err
+	}
+
+	labels := []string{"name", "target", "direction"}
+
+	bytesMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mikrotik_queue_simple_bytes",
+		Help: "Number of bytes passed through the simple queue",
+	}, labels)
+	registry.MustRegister(bytesMetric)
+
+	packetsMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mikrotik_queue_simple_packets",
+		Help: "Number of packets passed through the simple queue",
+	}, labels)
+	registry.MustRegister(packetsMetric)
+
+	droppedMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mikrotik_queue_simple_dropped",
+		Help: "Number of packets dropped by the simple queue",
+	}, labels)
+	registry.MustRegister(droppedMetric)
+
+	queuedBytesMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_queue_simple_queued_bytes",
+		Help: "Number of bytes currently queued in the simple queue",
+	}, labels)
+	registry.MustRegister(queuedBytesMetric)
+
+	queuedPacketsMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_queue_simple_queued_packets",
+		Help: "Number of packets currently queued in the simple queue",
+	}, labels)
+	registry.MustRegister(queuedPacketsMetric)
+
+	maxLimitMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_queue_simple_max_limit_bits",
+		Help: "Maximum data rate of the simple queue in bits per second, 0 if unlimited",
+	}, labels)
+	registry.MustRegister(maxLimitMetric)
+
+	for _, queue := range queues {
+		if queue.Disabled || !collector.Matches(queue.Name) {
+			continue
+		}
+
+		for _, direction := range []struct {
+			name  string
+			value func(mikrotik.UploadDownload) float64
+		}{
+			{"upload", func(u mikrotik.UploadDownload) float64 { return u.Upload }},
+			{"download", func(u mikrotik.UploadDownload) float64 { return u.Download }},
+		} {
+			bytesMetric.WithLabelValues(queue.Name, queue.Target, direction.name).Add(direction.value(queue.Bytes))
+			packetsMetric.WithLabelValues(queue.Name, queue.Target, direction.name).Add(direction.value(queue.Packets))
+			droppedMetric.WithLabelValues(queue.Name, queue.Target, direction.name).Add(direction.value(queue.Dropped))
+			queuedBytesMetric.WithLabelValues(queue.Name, queue.Target, direction.name).Set(direction.value(queue.QueuedBytes))
+			queuedPacketsMetric.WithLabelValues(queue.Name, queue.Target, direction.name).Set(direction.value(queue.QueuedPackets))
+			maxLimitMetric.WithLabelValues(queue.Name, queue.Target, direction.name).Set(direction.value(queue.MaxLimit))
+		}
+	}
+
+	return nil
+}
+
+func setQueueTreeMetrics(client mikrotik.Client, registry *prometheus.Registry, collector config.QueueCollector) error {
+	queues, err := client.GetQueueTree()
+	if err != nil {
+		return err
+	}
+
+	labels := []string{"name", "parent"}
+
+	bytesMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mikrotik_queue_tree_bytes",
+		Help: "Number of bytes passed through the queue",
+	}, labels)
+	registry.MustRegister(bytesMetric)
+
+	packetsMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mikrotik_queue_tree_packets",
+		Help: "Number of packets passed through the queue",
+	}, labels)
+	registry.MustRegister(packetsMetric)
+
+	droppedMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mikrotik_queue_tree_dropped",
+		Help: "Number of packets dropped by the queue",
+	}, labels)
+	registry.MustRegister(droppedMetric)
+
+	queuedBytesMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_queue_tree_queued_bytes",
+		Help: "Number of bytes currently queued in the queue",
+	}, labels)
+	registry.MustRegister(queuedBytesMetric)
+
+	queuedPacketsMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_queue_tree_queued_packets",
+		Help: "Number of packets currently queued in the queue",
+	}, labels)
+	registry.MustRegister(queuedPacketsMetric)
+
+	maxLimitMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_queue_tree_max_limit_bits",
+		Help: "Maximum data rate of the queue in bits per second, 0 if unlimited",
+	}, labels)
+	registry.MustRegister(maxLimitMetric)
+
+	for _, queue := range queues {
+		if queue.Disabled || !collector.Matches(queue.Name) {
+			continue
+		}
+
+		bytesMetric.WithLabelValues(queue.Name, queue.Parent).Add(queue.Bytes)
+		packetsMetric.WithLabelValues(queue.Name, queue.Parent).Add(queue.Packets)
+		droppedMetric.WithLabelValues(queue.Name, queue.Parent).Add(queue.Dropped)
+		queuedBytesMetric.WithLabelValues(queue.Name, queue.Parent).Set(queue.QueuedBytes)
+		queuedPacketsMetric.WithLabelValues(queue.Name, queue.Parent).Set(queue.QueuedPackets)
+		maxLimitMetric.WithLabelValues(queue.Name, queue.Parent).Set(float64(queue.MaxLimit))
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package metrics
+
+import (
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func setRouteMetrics(client mikrotik.Client, registry *prometheus.Registry) error {
+	counts, err := client.GetRouteCounts()
+	if err != nil {
+		return err
+	}
+
+	routesMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_routes",
+		Help: "Number of routes per address family, routing table, protocol and active flag",
+	}, []string{"address_family", "table", "protocol", "active"})
+	registry.MustRegister(routesMetric)
+
+	for _, count := range counts {
+		routesMetric.WithLabelValues(count.AddressFamily, count.Table, count.Protocol, "true").Set(count.Active)
+		routesMetric.WithLabelValues(count.AddressFamily, count.Table, count.Protocol, "false").Set(count.Total - count.Active)
+	}
+
+	return nil
+}
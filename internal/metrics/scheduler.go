@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func setSchedulerMetrics(client mikrotik.Client, registry *prometheus.Registry) error {
+	schedulers, err := client.GetSchedulers()
+	if err != nil {
+		return err
+	}
+
+	schedulerLabels := []string{"name", "comment"}
+
+	schedulerRunCountMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mikrotik_scheduler_run_count",
+		Help: "Number of times the scheduler ran since the last reboot",
+	}, schedulerLabels)
+	registry.MustRegister(schedulerRunCountMetric)
+
+	schedulerNextRunMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_scheduler_next_run_timestamp_seconds",
+		Help: "Unix timestamp of the next run of the scheduler",
+	}, schedulerLabels)
+	registry.MustRegister(schedulerNextRunMetric)
+
+	schedulerIntervalMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_scheduler_interval_seconds",
+		Help: "Interval between runs of the scheduler, 0 for a single run",
+	}, schedulerLabels)
+	registry.MustRegister(schedulerIntervalMetric)
+
+	schedulerDisabledMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_scheduler_disabled",
+		Help: "Whether the scheduler is disabled",
+	}, schedulerLabels)
+	registry.MustRegister(schedulerDisabledMetric)
+
+	for _, scheduler := range schedulers {
+		schedulerRunCountMetric.WithLabelValues(scheduler.Name, scheduler.Comment).Add(scheduler.RunCount)
+		if !scheduler.NextRunTime.IsZero() {
+			schedulerNextRunMetric.WithLabelValues(scheduler.Name, scheduler.Comment).Set(float64(scheduler.NextRunTime.Unix()))
+		}
+		schedulerIntervalMetric.WithLabelValues(scheduler.Name, scheduler.Comment).Set(float64(scheduler.Interval))
+		schedulerDisabledMetric.WithLabelValues(scheduler.Name, scheduler.Comment).Set(boolToFloat64(scheduler.Disabled))
+	}
+
+	scripts, err := client.GetScripts()
+	if err != nil {
+		return err
+	}
+
+	scriptRunCountMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mikrotik_script_run_count",
+		Help: "Number of times the script ran since the last reboot",
+	}, []string{"name", "comment"})
+	registry.MustRegister(scriptRunCountMetric)
+
+	scriptLastStartedMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_script_last_started_timestamp_seconds",
+		Help: "Unix timestamp of the last start of the script",
+	}, []string{"name", "comment"})
+	registry.MustRegister(scriptLastStartedMetric)
+
+	for _, script := range scripts {
+		scriptRunCountMetric.WithLabelValues(script.Name, script.Comment).Add(script.RunCount)
+		if !script.LastStartedTime.IsZero() {
+			scriptLastStartedMetric.WithLabelValues(script.Name, script.Comment).Set(float64(script.LastStartedTime.Unix()))
+		}
+	}
+
+	return nil
+}
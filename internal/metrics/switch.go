@@ -0,0 +1,91 @@
+package metrics
+
+import (
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func setSwitchMetrics(client mikrotik.Client, registry *prometheus.Registry) error {
+	ports, err := client.GetSwitchPorts()
+	if err != nil {
+		return err
+	}
+
+	portLabels := []string{"switch", "port"}
+
+	receivedBytesMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mikrotik_switch_port_received_bytes",
+		Help: "Number of bytes received by the switch chip port",
+	}, portLabels)
+	registry.MustRegister(receivedBytesMetric)
+
+	transferredBytesMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mikrotik_switch_port_transferred_bytes",
+		Help: "Number of bytes transmitted by the switch chip port",
+	}, portLabels)
+	registry.MustRegister(transferredBytesMetric)
+
+	receivedPacketsMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mikrotik_switch_port_received_packets",
+		Help: "Hardware receive packet and error counters of the switch chip port, e.g. unicast or fcs-error",
+	}, append(portLabels, "counter"))
+	registry.MustRegister(receivedPacketsMetric)
+
+	transferredPacketsMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mikrotik_switch_port_transferred_packets",
+		Help: "Hardware transmit packet and error counters of the switch chip port, e.g. unicast or drop",
+	}, append(portLabels, "counter"))
+	registry.MustRegister(transferredPacketsMetric)
+
+	for _, port := range ports {
+		for counter, value := range port.Received {
+			if counter == "bytes" {
+				receivedBytesMetric.WithLabelValues(port.Switch, port.Name).Add(value)
+				continue
+			}
+			receivedPacketsMetric.WithLabelValues(port.Switch, port.Name, counter).Add(value)
+		}
+		for counter, value := range port.Transferred {
+			if counter == "bytes" {
+				transferredBytesMetric.WithLabelValues(port.Switch, port.Name).Add(value)
+				continue
+			}
+			transferredPacketsMetric.WithLabelValues(port.Switch, port.Name, counter).Add(value)
+		}
+	}
+
+	rules, err := client.GetSwitchRules()
+	if err != nil {
+		return err
+	}
+
+	ruleLabels := []string{"switch", "id", "ports", "comment"}
+
+	ruleBytesMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mikrotik_switch_rule_bytes",
+		Help: "Number of bytes matched by the switch rule",
+	}, ruleLabels)
+	registry.MustRegister(ruleBytesMetric)
+
+	rulePacketsMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mikrotik_switch_rule_packets",
+		Help: "Number of packets matched by the switch rule",
+	}, ruleLabels)
+	registry.MustRegister(rulePacketsMetric)
+
+	for _, rule := range rules {
+		if rule.Disabled {
+			continue
+		}
+
+		labels := []string{rule.Switch, rule.Id, rule.Ports, rule.Comment}
+		if rule.Bytes != nil {
+			ruleBytesMetric.WithLabelValues(labels...).Add(*rule.Bytes)
+		}
+		if rule.Packets != nil {
+			rulePacketsMetric.WithLabelValues(labels...).Add(*rule.Packets)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package metrics
+
+import (
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/config"
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func setUpdateMetrics(client mikrotik.Client, registry *prometheus.Registry, collector config.UpdateCollector) error {
+	routerboard, err := client.GetRouterboard()
+	if err != nil {
+		return err
+	}
+
+	if routerboard.Routerboard {
+		firmwareMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "mikrotik_routerboard_firmware_upgrade_available",
+			Help: "Whether the RouterBOOT firmware can be upgraded to the version shipped with RouterOS",
+		}, []string{"current_firmware", "upgrade_firmware"})
+		registry.MustRegister(firmwareMetric)
+		firmwareMetric.WithLabelValues(routerboard.CurrentFirmware, routerboard.UpgradeFirmware).Set(boolToFloat64(routerboard.IsUpgradeAvailable()))
+	}
+
+	update, err := client.GetPackageUpdate(collector.CheckForUpdates)
+	if err != nil {
+		return err
+	}
+
+	packageMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_package_upgrade_available",
+		Help: "Whether a newer RouterOS version is available on the update channel",
+	}, []string{"channel", "installed_version", "latest_version"})
+	registry.MustRegister(packageMetric)
+	packageMetric.WithLabelValues(update.Channel, update.InstalledVersion, update.LatestVersion).Set(boolToFloat64(update.IsUpgradeAvailable()))
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/config"
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func setUserMetrics(client mikrotik.Client, registry *prometheus.Registry, collector config.PerUserCollector) error {
+	users, err := client.GetActiveUsers()
+	if err != nil {
+		return err
+	}
+
+	activeUsersMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_user_active_sessions",
+		Help: "Number of users logged into the router per group and access method",
+	}, []string{"group", "via"})
+	registry.MustRegister(activeUsersMetric)
+
+	for _, user := range users {
+		activeUsersMetric.WithLabelValues(user.Group, user.Via).Inc()
+	}
+
+	if !collector.PerUser {
+		return nil
+	}
+
+	sessionInfoMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_user_active_session_info",
+		Help: "User logged into the router",
+	}, []string{"name", "group", "via", "address", "when"})
+	registry.MustRegister(sessionInfoMetric)
+
+	for _, user := range users {
+		sessionInfoMetric.WithLabelValues(user.Name, user.Group, user.Via, user.Address, user.When).Set(1)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func setVrrpMetrics(client mikrotik.Client, registry *prometheus.Registry) error {
+	vrrps, err := client.GetVrrps()
+	if err != nil {
+		return err
+	}
+
+	labels := []string{"name", "interface", "vrid"}
+
+	stateMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_vrrp_state",
+		Help: "State of the VRRP instance, 1 for the current state",
+	}, append(labels, "state"))
+	registry.MustRegister(stateMetric)
+
+	priorityMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_vrrp_priority",
+		Help: "Priority of the VRRP instance",
+	}, labels)
+	registry.MustRegister(priorityMetric)
+
+	ownerMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_vrrp_owner",
+		Help: "Whether the router owns the virtual address of the VRRP instance",
+	}, labels)
+	registry.MustRegister(ownerMetric)
+
+	for _, vrrp := range vrrps {
+		if vrrp.Disabled {
+			continue
+		}
+
+		for _, state := range mikrotik.VrrpStates {
+			stateMetric.WithLabelValues(vrrp.Name, vrrp.Interface, vrrp.Vrid, state).Set(boolToFloat64(state == vrrp.State()))
+		}
+		priorityMetric.WithLabelValues(vrrp.Name, vrrp.Interface, vrrp.Vrid).Set(vrrp.Priority)
+		ownerMetric.WithLabelValues(vrrp.Name, vrrp.Interface, vrrp.Vrid).Set(boolToFloat64(vrrp.IsOwner()))
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"github.com/eatplanted/mikrotik-ros-exporter/internal/mikrotik"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func setWireguardMetrics(client mikrotik.Client, registry *prometheus.Registry) error {
+	peers, err := client.GetWireguardPeers()
+	if err != nil {
+		return err
+	}
+
+	peerLabels := []string{"interface", "comment", "public_key"}
+
+	lastHandshakeMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_wireguard_peer_last_handshake_seconds",
+		Help: "Seconds since the last handshake with the WireGuard peer, +Inf if there was none",
+	}, peerLabels)
+	registry.MustRegister(lastHandshakeMetric)
+
+	receivedBytesMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mikrotik_wireguard_peer_received_bytes",
+		Help: "Number of bytes received from the WireGuard peer",
+	}, peerLabels)
+	registry.MustRegister(receivedBytesMetric)
+
+	transferredBytesMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mikrotik_wireguard_peer_transferred_bytes",
+		Help: "Number of bytes transmitted to the WireGuard peer",
+	}, peerLabels)
+	registry.MustRegister(transferredBytesMetric)
+
+	infoMetric := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "mikrotik_wireguard_peer_info",
+		Help: "Endpoint and allowed addresses of the WireGuard peer",
+	}, append(peerLabels, "endpoint_address", "endpoint_port", "allowed_address"))
+	registry.MustRegister(infoMetric)
+
+	for _, peer := range peers {
+		if peer.Disabled {
+			continue
+		}
+
+		labels := []string{peer.Interface, peer.Comment, peer.Fingerprint()}
+		endpointAddress, endpointPort := peer.Endpoint()
+
+		lastHandshakeMetric.WithLabelValues(labels...).Set(peer.LastHandshakeAge())
+		receivedBytesMetric.WithLabelValues(labels...).Add(peer.Rx)
+		transferredBytesMetric.WithLabelValues(labels...).Add(peer.Tx)
+		infoMetric.WithLabelValues(append(labels, endpointAddress, endpointPort, peer.AllowedAddress)...).Set(1)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package mikrotik
+
+type BridgePort struct {
+	Id         string `json:".id"`
+	Interface  string `json:"interface"`
+	Bridge     string `json:"bridge"`
+	Role       string `json:"role"`
+	Status     string `json:"status"`
+	Forwarding bool   `json:"forwarding,string"`
+	Learning   bool   `json:"learning,string"`
+	EdgePort   bool   `json:"edge-port,string"`
+	Disabled   bool   `json:"disabled,string"`
+}
+
+type BridgeHost struct {
+	Bridge      string `json:"bridge"`
+	OnInterface string `json:"on-interface"`
+	Dynamic     bool   `json:"dynamic,string"`
+	Local       bool   `json:"local,string"`
+}
+
+// Type returns how the host entry was created: "local" for the bridge's own
+// addresses, "dynamic" for learned and "static" for configured ones.
+func (h *BridgeHost) Type() string {
+	switch {
+	case h.Local:
+		return "local"
+	case h.Dynamic:
+		return "dynamic"
+	}
+	return "static"
+}
+
+func (c *client) GetBridgePorts() ([]BridgePort, error) {
+	var ports []BridgePort
+	if err := c.getJSON("/interface/bridge/port", &ports); err != nil {
+		return nil, err
+	}
+
+	return ports, nil
+}
+
+// GetBridgeHosts returns the bridge host table reduced to the properties
+// needed for counting, as it can hold thousands of entries.
+func (c *client) GetBridgeHosts() ([]BridgeHost, error) {
+	var hosts []BridgeHost
+	if err := c.getJSON("/interface/bridge/host?.proplist=bridge,on-interface,dynamic,local", &hosts); err != nil {
+		return nil, err
+	}
+
+	return hosts, nil
+}
@@ -0,0 +1,41 @@
+package mikrotik
+
+import "time"
+
+type Certificate struct {
+	Id            string `json:".id"`
+	Name          string `json:"name"`
+	CommonName    string `json:"common-name"`
+	InvalidBefore string `json:"invalid-before"`
+	InvalidAfter  string `json:"invalid-after"`
+	Trusted       bool   `json:"trusted,string"`
+	Expired       bool   `json:"expired,string"`
+	Revoked       bool   `json:"revoked,string"`
+	Invalid       bool   `json:"invalid,string"`
+
+	NotBefore time.Time `json:"-"`
+	NotAfter  time.Time `json:"-"`
+}
+
+func (c *Certificate) IsValid() bool {
+	return !c.Expired && !c.Revoked && !c.Invalid
+}
+
+func (c *client) GetCertificates() ([]Certificate, error) {
+	clock, err := c.GetClock()
+	if err != nil {
+		return nil, err
+	}
+
+	var certificates []Certificate
+	if err := c.getJSON("/certificate", &certificates); err != nil {
+		return nil, err
+	}
+
+	for i := range certificates {
+		certificates[i].NotBefore = clock.parseTimeOrZero(certificates[i].InvalidBefore)
+		certificates[i].NotAfter = clock.parseTimeOrZero(certificates[i].InvalidAfter)
+	}
+
+	return certificates, nil
+}
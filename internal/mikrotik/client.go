@@ -1,9 +1,14 @@
 package mikrotik
 
 import (
+	"bytes"
 	"crypto/tls"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -19,6 +24,44 @@ type Client interface {
 	GetHealth() (Health, error)
 	GetInterfaces() ([]Interface, error)
 	GetResource() (Resource, error)
+	GetIdentity() (Identity, error)
+	GetRouterboard() (Routerboard, error)
+	GetPackageUpdate(checkForUpdates bool) (PackageUpdate, error)
+	GetCertificates() ([]Certificate, error)
+	GetClock() (Clock, error)
+	GetNtpClient() (NtpClient, error)
+	GetDisks() ([]Disk, error)
+	GetContainers() ([]Container, error)
+	GetContainerConfig() (ContainerConfig, error)
+	GetLteMonitors() ([]LteMonitor, error)
+	GetVrrps() ([]Vrrp, error)
+	GetBridgePorts() ([]BridgePort, error)
+	GetBridgeHosts() ([]BridgeHost, error)
+	GetArpEntries() ([]ArpEntry, error)
+	GetIpv6Neighbors() ([]Ipv6Neighbor, error)
+	GetDns() (Dns, error)
+	GetDnsCache() ([]DnsCacheEntry, error)
+	GetHotspot() (Hotspot, error)
+	GetMpls() (Mpls, error)
+	GetSwitchPorts() ([]SwitchPort, error)
+	GetSwitchRules() ([]SwitchRule, error)
+	GetActiveUsers() ([]ActiveUser, error)
+	GetSchedulers() ([]Scheduler, error)
+	GetScripts() ([]Script, error)
+	GetRouteCounts() ([]RouteCount, error)
+	GetIpsec() (Ipsec, error)
+	GetWireguardPeers() ([]WireguardPeer, error)
+	GetPpp() (Ppp, error)
+	GetSimpleQueues() ([]SimpleQueue, error)
+	GetQueueTree() ([]QueueTree, error)
+	GetNetwatch() ([]Netwatch, error)
+	GetPoe() (Poe, error)
+	GetCpus() ([]Cpu, error)
+	GetIrqs() ([]Irq, error)
+}
+
+type countResponse struct {
+	Ret string `json:"ret"`
 }
 
 type client struct {
@@ -53,6 +96,121 @@ func (c *client) get(path string) (*http.Response, error) {
 	return c.httpClient.Do(request)
 }
 
+func (c *client) post(path string, body interface{}) (*http.Response, error) {
+	url := c.buildURL(path)
+
+	content, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	request.SetBasicAuth(c.configuration.Username, c.configuration.Password)
+	request.Header.Set("Content-Type", "application/json")
+
+	return c.httpClient.Do(request)
+}
+
+// getJSON requests the given path and decodes the JSON response into v.
+func (c *client) getJSON(path string, v interface{}) error {
+	resp, err := c.get(path)
+	if err != nil {
+		return err
+	}
+
+	return decodeResponse(resp, v)
+}
+
+// postJSON sends body to the given path and decodes the JSON response into v.
+// RouterOS uses POST for commands such as print with queries or monitor.
+func (c *client) postJSON(path string, body interface{}, v interface{}) error {
+	resp, err := c.post(path, body)
+	if err != nil {
+		return err
+	}
+
+	return decodeResponse(resp, v)
+}
+
+// count runs a count-only print so that the router does not have to
+// serialize the entries themselves, which matters on full-table routers.
+func (c *client) count(path string, query ...string) (float64, error) {
+	body := map[string]interface{}{
+		"count-only": "",
+	}
+	if len(query) > 0 {
+		body[".query"] = query
+	}
+
+	var raw json.RawMessage
+	if err := c.postJSON(path, body, &raw); err != nil {
+		return 0, err
+	}
+
+	return parseCount(raw)
+}
+
+func parseCount(raw json.RawMessage) (float64, error) {
+	var result countResponse
+	if err := json.Unmarshal(raw, &result); err != nil {
+		// Some RouterOS versions wrap the result in a list.
+		var results []countResponse
+		if err := json.Unmarshal(raw, &results); err != nil {
+			return 0, err
+		}
+		if len(results) != 1 {
+			return 0, fmt.Errorf("unexpected count response: %s", raw)
+		}
+		result = results[0]
+	}
+
+	return strconv.ParseFloat(result.Ret, 64)
+}
+
+// StatusError is returned for responses with a status code other than 200.
+// Detail holds the reason RouterOS gives in the error body, if any.
+type StatusError struct {
+	StatusCode int
+	Detail     string
+}
+
+func (e *StatusError) Error() string {
+	if e.Detail != "" {
+		return fmt.Sprintf("received invalid status code: %d: %s", e.StatusCode, e.Detail)
+	}
+	return fmt.Sprintf("received invalid status code: %d", e.StatusCode)
+}
+
+// isMissingMenu reports whether err was caused by a menu that does not exist
+// on the device, like /interface/ethernet/poe on boards without PoE.
+func isMissingMenu(err error) bool {
+	var statusError *StatusError
+	if !errors.As(err, &statusError) {
+		return false
+	}
+
+	return statusError.StatusCode == http.StatusNotFound ||
+		statusError.StatusCode == http.StatusBadRequest && strings.Contains(statusError.Detail, "no such command")
+}
+
+func decodeResponse(resp *http.Response, v interface{}) error {
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		var body struct {
+			Detail string `json:"detail"`
+		}
+		json.NewDecoder(resp.Body).Decode(&body)
+
+		return &StatusError{StatusCode: resp.StatusCode, Detail: body.Detail}
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
 func (c *client) buildURL(path string) string {
 	return fmt.Sprintf("%s/rest%s", c.configuration.Address, path)
 }
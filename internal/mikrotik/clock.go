@@ -0,0 +1,147 @@
+package mikrotik
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Clock struct {
+	Time         string `json:"time"`
+	Date         string `json:"date"`
+	TimeZoneName string `json:"time-zone-name"`
+	GmtOffset    string `json:"gmt-offset"`
+}
+
+// Location returns the fixed zone the router formats its timestamps in.
+func (c *Clock) Location() (*time.Location, error) {
+	if c.GmtOffset == "" {
+		return time.UTC, nil
+	}
+
+	sign := 1
+	offset := c.GmtOffset
+	switch offset[0] {
+	case '-':
+		sign = -1
+		offset = offset[1:]
+	case '+':
+		offset = offset[1:]
+	}
+
+	hours, minutes, _ := strings.Cut(offset, ":")
+	h, err := strconv.Atoi(hours)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gmt offset: %q", c.GmtOffset)
+	}
+	var m int
+	if minutes != "" {
+		if m, err = strconv.Atoi(minutes); err != nil {
+			return nil, fmt.Errorf("invalid gmt offset: %q", c.GmtOffset)
+		}
+	}
+
+	return time.FixedZone(c.TimeZoneName, sign*(h*60*60+m*60)), nil
+}
+
+// Now returns the time of the router clock when it was queried.
+func (c *Clock) Now() (time.Time, error) {
+	location, err := c.Location()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return ParseTime(c.Date+" "+c.Time, location)
+}
+
+// yearlessLayouts are the formats RouterOS uses for timestamps within the
+// current year, e.g. "oct/19 10:00:00" or "10-19 10:00:00".
+var yearlessLayouts = []string{
+	"Jan/02 15:04:05",
+	"01-02 15:04:05",
+}
+
+// ParseTime parses a timestamp in the router's zone. Values that only
+// contain a time of day, as used for times later today, are combined with
+// the router's date. Values without a year get the year of the router's
+// date, or the adjacent one if that puts them closer to it, so that
+// "dec/31" seen on the first of January is in the past.
+func (c *Clock) ParseTime(value string) (time.Time, error) {
+	location, err := c.Location()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if _, err := time.Parse("15:04:05", value); err == nil {
+		value = c.Date + " " + value
+	}
+
+	for _, layout := range yearlessLayouts {
+		t, err := time.ParseInLocation(layout, value, location)
+		if err != nil {
+			continue
+		}
+
+		today, err := ParseTime(c.Date, location)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		t = time.Date(today.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, location)
+		switch {
+		case t.Sub(today) > 183*24*time.Hour:
+			t = t.AddDate(-1, 0, 0)
+		case today.Sub(t) > 183*24*time.Hour:
+			t = t.AddDate(1, 0, 0)
+		}
+		return t, nil
+	}
+
+	return ParseTime(value, location)
+}
+
+// parseTimeOrZero parses a timestamp like ParseTime, but returns the zero
+// time for a value in an unknown format, so that it only leaves that one
+// time unset instead of failing the whole query.
+func (c *Clock) parseTimeOrZero(value string) time.Time {
+	t, err := c.ParseTime(value)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+func (c *client) GetClock() (Clock, error) {
+	var clock Clock
+	if err := c.getJSON("/system/clock", &clock); err != nil {
+		return Clock{}, err
+	}
+
+	return clock, nil
+}
+
+// timeLayouts are the formats RouterOS uses for timestamps. Versions before
+// 7.10 use "jan/02/2006", later ones ISO dates.
+var timeLayouts = []string{
+	"2006-01-02 15:04:05",
+	"Jan/02/2006 15:04:05",
+	"2006-01-02",
+	"Jan/02/2006",
+}
+
+// ParseTime parses a RouterOS timestamp in the given location. An empty value
+// results in the zero time.
+func ParseTime(value string, location *time.Location) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, value, location); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time: %q", value)
+}
@@ -0,0 +1,114 @@
+package mikrotik
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTime(t *testing.T) {
+	location := time.FixedZone("", 2*60*60)
+
+	var testSuite = []struct {
+		in  string
+		out int64
+	}{
+		{"2023-05-01 12:00:00", 1682935200},
+		{"may/01/2023 12:00:00", 1682935200},
+		{"2023-05-01", 1682892000},
+		{"may/01/2023", 1682892000},
+	}
+
+	for _, test := range testSuite {
+		parsed, err := ParseTime(test.in, location)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if parsed.Unix() != test.out {
+			t.Errorf("time %q is incorrect: %v, want %v", test.in, parsed.Unix(), test.out)
+		}
+	}
+
+	if _, err := ParseTime("yesterday", location); err == nil {
+		t.Error("time \"yesterday\" should be invalid")
+	}
+}
+
+func TestClockLocation(t *testing.T) {
+	var testSuite = []struct {
+		in     string
+		offset int
+	}{
+		{"", 0},
+		{"+02:00", 2 * 60 * 60},
+		{"-05:30", -(5*60*60 + 30*60)},
+	}
+
+	for _, test := range testSuite {
+		clock := Clock{GmtOffset: test.in}
+		location, err := clock.Location()
+		if err != nil {
+			t.Error(err)
+		}
+
+		if _, offset := time.Now().In(location).Zone(); offset != test.offset {
+			t.Errorf("offset %q is incorrect: %v, want %v", test.in, offset, test.offset)
+		}
+	}
+}
+
+func TestClockParseTime(t *testing.T) {
+	clock := Clock{Date: "2023-05-01", GmtOffset: "+02:00"}
+
+	var testSuite = []struct {
+		in  string
+		out int64
+	}{
+		{"12:00:00", 1682935200},
+		{"2023-05-01 12:00:00", 1682935200},
+		{"may/01 12:00:00", 1682935200},
+		{"05-01 12:00:00", 1682935200},
+		{"oct/19 10:00:00", 1697702400},
+		{"10-19 10:00:00", 1697702400},
+	}
+
+	for _, test := range testSuite {
+		parsed, err := clock.ParseTime(test.in)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if parsed.Unix() != test.out {
+			t.Errorf("time %q is incorrect: %v, want %v", test.in, parsed.Unix(), test.out)
+		}
+	}
+
+	if _, err := clock.ParseTime("soon"); err == nil {
+		t.Error("time \"soon\" should be invalid")
+	}
+}
+
+func TestClockParseTimeAcrossNewYear(t *testing.T) {
+	var testSuite = []struct {
+		date string
+		in   string
+		year int
+	}{
+		{"2024-01-01", "dec/31 23:00:00", 2023},
+		{"2023-12-31", "jan/01 01:00:00", 2024},
+		{"jan/01/2024", "12-31 23:00:00", 2023},
+	}
+
+	for _, test := range testSuite {
+		clock := Clock{Date: test.date}
+
+		parsed, err := clock.ParseTime(test.in)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if parsed.Year() != test.year {
+			t.Errorf("year of %q on %s is incorrect: %v, want %v", test.in, test.date, parsed.Year(), test.year)
+		}
+	}
+}
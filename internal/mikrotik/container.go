@@ -0,0 +1,53 @@
+package mikrotik
+
+type Container struct {
+	Id          string `json:".id"`
+	Name        string `json:"name"`
+	Tag         string `json:"tag"`
+	Interface   string `json:"interface"`
+	RootDir     string `json:"root-dir"`
+	Status      string `json:"status"`
+	StartOnBoot bool   `json:"start-on-boot,string"`
+	Comment     string `json:"comment"`
+}
+
+func (c *Container) IsRunning() bool {
+	return c.Status == "running"
+}
+
+// DisplayName returns the container name, which older RouterOS versions do
+// not have, falling back to the image tag.
+func (c *Container) DisplayName() string {
+	if c.Name != "" {
+		return c.Name
+	}
+	return c.Tag
+}
+
+type ContainerConfig struct {
+	RegistryUrl string `json:"registry-url"`
+	Tmpdir      string `json:"tmpdir"`
+	RamHigh     string `json:"ram-high"`
+}
+
+// GetContainers returns no containers if the container package is not
+// installed.
+func (c *client) GetContainers() ([]Container, error) {
+	var containers []Container
+	if err := c.getJSON("/container", &containers); err != nil && !isMissingMenu(err) {
+		return nil, err
+	}
+
+	return containers, nil
+}
+
+// GetContainerConfig returns an empty configuration if the container package
+// is not installed.
+func (c *client) GetContainerConfig() (ContainerConfig, error) {
+	var config ContainerConfig
+	if err := c.getJSON("/container/config", &config); err != nil && !isMissingMenu(err) {
+		return ContainerConfig{}, err
+	}
+
+	return config, nil
+}
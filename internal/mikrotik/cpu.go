@@ -0,0 +1,36 @@
+package mikrotik
+
+type Cpu struct {
+	Id   string  `json:".id"`
+	Cpu  string  `json:"cpu"`
+	Load float64 `json:"load,string"`
+	Irq  float64 `json:"irq,string"`
+	Disk float64 `json:"disk,string"`
+}
+
+type Irq struct {
+	Id        string  `json:".id"`
+	Irq       string  `json:"irq"`
+	Users     string  `json:"users"`
+	Cpu       string  `json:"cpu"`
+	ActiveCpu string  `json:"active-cpu"`
+	Count     float64 `json:"count,string"`
+}
+
+func (c *client) GetCpus() ([]Cpu, error) {
+	var cpus []Cpu
+	if err := c.getJSON("/system/resource/cpu", &cpus); err != nil {
+		return nil, err
+	}
+
+	return cpus, nil
+}
+
+func (c *client) GetIrqs() ([]Irq, error) {
+	var irqs []Irq
+	if err := c.getJSON("/system/resource/irq", &irqs); err != nil {
+		return nil, err
+	}
+
+	return irqs, nil
+}
@@ -0,0 +1,42 @@
+package mikrotik
+
+type Disk struct {
+	Id         string   `json:".id"`
+	Slot       string   `json:"slot"`
+	Type       string   `json:"type"`
+	Model      string   `json:"model"`
+	Serial     string   `json:"serial"`
+	Fs         string   `json:"fs"`
+	Size       *float64 `json:"size,string"`
+	Free       *float64 `json:"free,string"`
+	RaidType   string   `json:"raid-type"`
+	RaidMaster string   `json:"raid-master"`
+	RaidState  string   `json:"raid-state"`
+	Disabled   bool     `json:"disabled,string"`
+}
+
+func (d *Disk) IsRaid() bool {
+	return d.Type == "raid"
+}
+
+// RaidMembers returns the number of member disks of every RAID array, keyed
+// by the slot of the array.
+func RaidMembers(disks []Disk) map[string]float64 {
+	members := make(map[string]float64)
+	for _, disk := range disks {
+		if disk.RaidMaster != "" && disk.RaidMaster != "none" {
+			members[disk.RaidMaster]++
+		}
+	}
+	return members
+}
+
+// GetDisks returns the disks of /disk, which only exists on RouterOS 7.
+func (c *client) GetDisks() ([]Disk, error) {
+	var disks []Disk
+	if err := c.getJSON("/disk", &disks); err != nil {
+		return nil, err
+	}
+
+	return disks, nil
+}
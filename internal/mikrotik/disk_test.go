@@ -0,0 +1,37 @@
+package mikrotik
+
+import "testing"
+
+func TestRaidMembers(t *testing.T) {
+	disks := []Disk{
+		{Slot: "raid1", Type: "raid", RaidMaster: "none"},
+		{Slot: "sata1", Type: "hw", RaidMaster: "raid1"},
+		{Slot: "sata2", Type: "hw", RaidMaster: "raid1"},
+		{Slot: "raid2", Type: "raid"},
+		{Slot: "nvme1", Type: "hw", RaidMaster: "raid2"},
+		{Slot: "usb1", Type: "hw", RaidMaster: "none"},
+		{Slot: "usb2", Type: "hw"},
+	}
+
+	var testSuite = []struct {
+		slot string
+		out  float64
+	}{
+		{"raid1", 2},
+		{"raid2", 1},
+		{"raid3", 0},
+		{"none", 0},
+		{"", 0},
+	}
+
+	members := RaidMembers(disks)
+	for _, test := range testSuite {
+		if members[test.slot] != test.out {
+			t.Errorf("members of %q are incorrect: %v, want %v", test.slot, members[test.slot], test.out)
+		}
+	}
+
+	if len(members) != 2 {
+		t.Errorf("RAID arrays are incorrect: %v", members)
+	}
+}
@@ -0,0 +1,61 @@
+package mikrotik
+
+import (
+	"strconv"
+	"strings"
+)
+
+type Dns struct {
+	Servers             string `json:"servers"`
+	AllowRemoteRequests bool   `json:"allow-remote-requests,string"`
+	UseDohServer        string `json:"use-doh-server"`
+	CacheSize           string `json:"cache-size"`
+	CacheUsed           string `json:"cache-used"`
+}
+
+func (d *Dns) IsDohEnabled() bool {
+	return d.UseDohServer != ""
+}
+
+type DnsCacheEntry struct {
+	Type string `json:"type"`
+}
+
+// ParseKibibytes parses a size such as "2048KiB" into bytes. RouterOS reports
+// DNS cache sizes in KiB, with or without the unit.
+func ParseKibibytes(value string) (float64, error) {
+	multiplier := 1024.0
+	switch {
+	case strings.HasSuffix(value, "MiB"):
+		multiplier = 1024 * 1024
+		value = strings.TrimSuffix(value, "MiB")
+	case strings.HasSuffix(value, "KiB"):
+		value = strings.TrimSuffix(value, "KiB")
+	}
+
+	size, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return size * multiplier, nil
+}
+
+func (c *client) GetDns() (Dns, error) {
+	var dns Dns
+	if err := c.getJSON("/ip/dns", &dns); err != nil {
+		return Dns{}, err
+	}
+
+	return dns, nil
+}
+
+// GetDnsCache returns the DNS cache reduced to the record type.
+func (c *client) GetDnsCache() ([]DnsCacheEntry, error) {
+	var entries []DnsCacheEntry
+	if err := c.getJSON("/ip/dns/cache?.proplist=type", &entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,25 @@
+package mikrotik
+
+import "testing"
+
+func TestParseKibibytes(t *testing.T) {
+	var testSuite = []struct {
+		in  string
+		out float64
+	}{
+		{"2048", 2048 * 1024},
+		{"2048KiB", 2048 * 1024},
+		{"4MiB", 4 * 1024 * 1024},
+	}
+
+	for _, test := range testSuite {
+		size, err := ParseKibibytes(test.in)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if size != test.out {
+			t.Errorf("size %q is incorrect: %v, want %v", test.in, size, test.out)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package mikrotik
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Duration is a RouterOS time interval in seconds. RouterOS formats them as
+// "1w2d3h4m5s", optionally with "ms" and "us" parts, or as "hh:mm:ss".
+type Duration float64
+
+var durationUnits = map[string]float64{
+	"w":  7 * 24 * 60 * 60,
+	"d":  24 * 60 * 60,
+	"h":  60 * 60,
+	"m":  60,
+	"s":  1,
+	"ms": 1e-3,
+	"us": 1e-6,
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	seconds, err := ParseDuration(value)
+	if err != nil {
+		return err
+	}
+
+	*d = Duration(seconds)
+	return nil
+}
+
+// ParseDuration parses a RouterOS time interval into seconds. An empty value
+// is treated as zero.
+func ParseDuration(value string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	if strings.Contains(value, ":") {
+		return parseClockDuration(value)
+	}
+
+	var seconds float64
+	rest := value
+	for rest != "" {
+		i := strings.IndexFunc(rest, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+		if i <= 0 {
+			return 0, fmt.Errorf("invalid duration: %q", value)
+		}
+		number, err := strconv.ParseFloat(rest[:i], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration: %q", value)
+		}
+		rest = rest[i:]
+
+		j := strings.IndexFunc(rest, func(r rune) bool { return r >= '0' && r <= '9' })
+		if j < 0 {
+			j = len(rest)
+		}
+		unit, ok := durationUnits[rest[:j]]
+		if !ok {
+			return 0, fmt.Errorf("invalid duration: %q", value)
+		}
+		rest = rest[j:]
+
+		seconds += number * unit
+	}
+
+	return seconds, nil
+}
+
+// parseClockDuration parses "hh:mm:ss" values, which may be prefixed with a
+// number of days or weeks such as "1d02:03:04".
+func parseClockDuration(value string) (float64, error) {
+	var prefix float64
+	clock := value
+	if i := strings.LastIndexAny(value, "wd"); i >= 0 {
+		var err error
+		prefix, err = ParseDuration(value[:i+1])
+		if err != nil {
+			return 0, err
+		}
+		clock = value[i+1:]
+	}
+
+	parts := strings.Split(clock, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid duration: %q", value)
+	}
+
+	var seconds float64
+	for i, unit := range []time.Duration{time.Hour, time.Minute, time.Second} {
+		number, err := strconv.ParseFloat(parts[i], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration: %q", value)
+		}
+		seconds += number * unit.Seconds()
+	}
+
+	return prefix + seconds, nil
+}
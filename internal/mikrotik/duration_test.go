@@ -0,0 +1,34 @@
+package mikrotik
+
+import "testing"
+
+func TestParseDuration(t *testing.T) {
+	var testSuite = []struct {
+		in  string
+		out float64
+	}{
+		{"", 0},
+		{"5s", 5},
+		{"1w2d3h4m5s", 788645},
+		{"1m30s250ms", 90.25},
+		{"00:01:02", 62},
+		{"1d00:00:10", 86410},
+	}
+
+	for _, test := range testSuite {
+		seconds, err := ParseDuration(test.in)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if seconds != test.out {
+			t.Errorf("duration %q is incorrect: %v, want %v", test.in, seconds, test.out)
+		}
+	}
+
+	for _, in := range []string{"abc", "5x", "1:2"} {
+		if _, err := ParseDuration(in); err == nil {
+			t.Errorf("duration %q should be invalid", in)
+		}
+	}
+}
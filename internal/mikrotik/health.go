@@ -1,15 +1,55 @@
 package mikrotik
 
 import (
+	"bytes"
 	"encoding/json"
-	"errors"
-	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 )
 
 type Health struct {
 	Voltage     float64
 	Temperature float64
+	Sensors     []HealthSensor
+}
+
+// HealthSensor is a single value of /system/health. States such as "ok" or
+// "fail" are kept in State, numeric values in Value.
+type HealthSensor struct {
+	Name    string
+	Unit    string
+	Value   float64
+	State   string
+	Numeric bool
+}
+
+var (
+	fanSpeedPattern = regexp.MustCompile(`^fan\d*-speed$`)
+	psuStatePattern = regexp.MustCompile(`^psu\d*-state$`)
+)
+
+// IsFanSpeed reports whether the sensor is a fan speed such as "fan1-speed".
+func (s *HealthSensor) IsFanSpeed() bool {
+	return fanSpeedPattern.MatchString(s.Name)
+}
+
+// IsPsuState reports whether the sensor is a PSU state such as "psu1-state".
+func (s *HealthSensor) IsPsuState() bool {
+	return psuStatePattern.MatchString(s.Name)
+}
+
+// StateValue maps states to 1 for ok and 0 for failure. The second return
+// value is false for states that are neither, like a fan mode.
+func (s *HealthSensor) StateValue() (float64, bool) {
+	switch strings.ToLower(s.State) {
+	case "ok", "on", "true", "yes":
+		return 1, true
+	case "fail", "off", "false", "no":
+		return 0, true
+	}
+	return 0, false
 }
 
 type response struct {
@@ -20,37 +60,97 @@ type response struct {
 }
 
 func (c *client) GetHealth() (Health, error) {
-	resp, err := c.get("/system/health")
+	sensors, err := c.getHealthSensors()
 	if err != nil {
 		return Health{}, err
 	}
 
-	if resp.StatusCode != 200 {
-		errorMessage := fmt.Sprintf("received invalid status code: %d", resp.StatusCode)
-		return Health{}, errors.New(errorMessage)
-	}
+	health := Health{Sensors: sensors}
 
-	defer resp.Body.Close()
-	var response []response
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return Health{}, err
-	}
-
-	var health Health
+	for _, sensor := range sensors {
+		if !sensor.Numeric {
+			continue
+		}
 
-	for _, r := range response {
-		switch r.Name {
+		switch sensor.Name {
 		case "voltage":
-			health.Voltage, err = strconv.ParseFloat(r.Value, 64)
-			if err != nil {
-				return Health{}, err
-			}
+			health.Voltage = sensor.Value
 		case "temperature":
-			health.Temperature, err = strconv.ParseFloat(r.Value, 64)
-			if err != nil {
-				return Health{}, err
-			}
+			health.Temperature = sensor.Value
 		}
 	}
 	return health, nil
 }
+
+// getHealthSensors decodes /system/health, which RouterOS 7 returns as a list
+// of named sensors and RouterOS 6 as a single object.
+func (c *client) getHealthSensors() ([]HealthSensor, error) {
+	var raw json.RawMessage
+	if err := c.getJSON("/system/health", &raw); err != nil {
+		return nil, err
+	}
+
+	return parseHealthSensors(raw)
+}
+
+func parseHealthSensors(raw json.RawMessage) ([]HealthSensor, error) {
+	var sensors []HealthSensor
+
+	if bytes.HasPrefix(bytes.TrimSpace(raw), []byte("[")) {
+		var response []response
+		if err := json.Unmarshal(raw, &response); err != nil {
+			return nil, err
+		}
+
+		for _, r := range response {
+			sensors = append(sensors, newHealthSensor(r.Name, r.Type, r.Value))
+		}
+		return sensors, nil
+	}
+
+	var values map[string]string
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return nil, err
+	}
+
+	for name, value := range values {
+		if name == ".id" {
+			continue
+		}
+		sensors = append(sensors, newHealthSensor(name, guessHealthUnit(name), value))
+	}
+
+	sort.Slice(sensors, func(i, j int) bool { return sensors[i].Name < sensors[j].Name })
+	return sensors, nil
+}
+
+func newHealthSensor(name, unit, value string) HealthSensor {
+	sensor := HealthSensor{Name: name, Unit: unit}
+
+	if number, err := strconv.ParseFloat(value, 64); err == nil {
+		sensor.Value = number
+		sensor.Numeric = true
+	} else {
+		sensor.State = value
+	}
+
+	return sensor
+}
+
+// guessHealthUnit derives the unit from the sensor name, as RouterOS 6 does
+// not report one.
+func guessHealthUnit(name string) string {
+	switch {
+	case strings.Contains(name, "temperature"):
+		return "C"
+	case strings.Contains(name, "voltage"):
+		return "V"
+	case strings.Contains(name, "current"):
+		return "A"
+	case strings.Contains(name, "power"), strings.Contains(name, "consumption"):
+		return "W"
+	case strings.HasSuffix(name, "-speed"):
+		return "RPM"
+	}
+	return ""
+}
@@ -0,0 +1,57 @@
+package mikrotik
+
+import "testing"
+
+func TestHealthSensorsAreParsed(t *testing.T) {
+	var testSuite = []struct {
+		name string
+		in   string
+	}{
+		{"RouterOS 7", `[
+			{".id": "*D", "name": "cpu-temperature", "type": "C", "value": "52"},
+			{".id": "*E", "name": "fan1-speed", "type": "RPM", "value": "4200"},
+			{".id": "*F", "name": "psu1-state", "type": "", "value": "ok"},
+			{".id": "*10", "name": "psu2-state", "type": "", "value": "fail"}
+		]`},
+		{"RouterOS 6", `{
+			"cpu-temperature": "52",
+			"fan1-speed": "4200",
+			"psu1-state": "ok",
+			"psu2-state": "fail"
+		}`},
+	}
+
+	for _, test := range testSuite {
+		sensors, err := parseHealthSensors([]byte(test.in))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(sensors) != 4 {
+			t.Fatalf("%s: got %d sensors, want 4", test.name, len(sensors))
+		}
+
+		byName := make(map[string]HealthSensor)
+		for _, sensor := range sensors {
+			byName[sensor.Name] = sensor
+		}
+
+		if s := byName["cpu-temperature"]; !s.Numeric || s.Value != 52 || s.Unit != "C" {
+			t.Errorf("%s: cpu-temperature is incorrect: %+v", test.name, s)
+		}
+
+		if s := byName["fan1-speed"]; !s.IsFanSpeed() || s.Value != 4200 || s.Unit != "RPM" {
+			t.Errorf("%s: fan1-speed is incorrect: %+v", test.name, s)
+		}
+
+		if s := byName["psu1-state"]; !s.IsPsuState() || s.State != "ok" {
+			t.Errorf("%s: psu1-state is incorrect: %+v", test.name, s)
+		}
+
+		if s := byName["psu2-state"]; s.Numeric {
+			t.Errorf("%s: psu2-state should not be numeric: %+v", test.name, s)
+		} else if value, ok := s.StateValue(); !ok || value != 0 {
+			t.Errorf("%s: psu2-state value is incorrect: %v", test.name, value)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package mikrotik
+
+type HotspotActive struct {
+	Id              string    `json:".id"`
+	Server          string    `json:"server"`
+	User            string    `json:"user"`
+	Address         string    `json:"address"`
+	MacAddress      string    `json:"mac-address"`
+	Uptime          Duration  `json:"uptime"`
+	SessionTimeLeft *Duration `json:"session-time-left"`
+	BytesIn         float64   `json:"bytes-in,string"`
+	BytesOut        float64   `json:"bytes-out,string"`
+}
+
+type HotspotHost struct {
+	Server     string `json:"server"`
+	Authorized bool   `json:"authorized,string"`
+}
+
+type Hotspot struct {
+	Active []HotspotActive
+	Hosts  []HotspotHost
+}
+
+func (c *client) GetHotspot() (Hotspot, error) {
+	var hotspot Hotspot
+
+	if err := c.getJSON("/ip/hotspot/active", &hotspot.Active); err != nil {
+		return Hotspot{}, err
+	}
+
+	if err := c.getJSON("/ip/hotspot/host?.proplist=server,authorized", &hotspot.Hosts); err != nil {
+		return Hotspot{}, err
+	}
+
+	return hotspot, nil
+}
@@ -0,0 +1,86 @@
+package mikrotik
+
+import "net"
+
+type IpsecPeer struct {
+	Id            string   `json:".id"`
+	Peer          string   `json:"peer"`
+	LocalAddress  string   `json:"local-address"`
+	RemoteAddress string   `json:"remote-address"`
+	State         string   `json:"state"`
+	Side          string   `json:"side"`
+	Uptime        Duration `json:"uptime"`
+	RxByte        float64  `json:"rx-bytes,string"`
+	RxPacket      float64  `json:"rx-packets,string"`
+	TxByte        float64  `json:"tx-bytes,string"`
+	TxPacket      float64  `json:"tx-packets,string"`
+}
+
+func (p *IpsecPeer) IsEstablished() bool {
+	return p.State == "established"
+}
+
+type IpsecInstalledSA struct {
+	Id         string `json:".id"`
+	Spi        string `json:"spi"`
+	SrcAddress string `json:"src-address"`
+	DstAddress string `json:"dst-address"`
+	State      string `json:"state"`
+}
+
+type IpsecPolicy struct {
+	Id         string `json:".id"`
+	SrcAddress string `json:"src-address"`
+	DstAddress string `json:"dst-address"`
+	Peer       string `json:"peer"`
+	Comment    string `json:"comment"`
+	Ph2State   string `json:"ph2-state"`
+	Active     bool   `json:"active,string"`
+	Disabled   bool   `json:"disabled,string"`
+	Template   bool   `json:"template,string"`
+}
+
+type Ipsec struct {
+	Peers        []IpsecPeer
+	InstalledSAs []IpsecInstalledSA
+	Policies     []IpsecPolicy
+}
+
+func (c *client) GetIpsec() (Ipsec, error) {
+	var ipsec Ipsec
+
+	if err := c.getJSON("/ip/ipsec/active-peers", &ipsec.Peers); err != nil {
+		return Ipsec{}, err
+	}
+
+	if err := c.getJSON("/ip/ipsec/installed-sa", &ipsec.InstalledSAs); err != nil {
+		return Ipsec{}, err
+	}
+
+	if err := c.getJSON("/ip/ipsec/policy", &ipsec.Policies); err != nil {
+		return Ipsec{}, err
+	}
+
+	return ipsec, nil
+}
+
+// CountInstalledSAs returns the number of installed SAs from or to the
+// remote address of the given peer.
+func (i *Ipsec) CountInstalledSAs(peer IpsecPeer) float64 {
+	var count float64
+	for _, sa := range i.InstalledSAs {
+		remoteAddress := stripPort(peer.RemoteAddress)
+		if stripPort(sa.SrcAddress) == remoteAddress || stripPort(sa.DstAddress) == remoteAddress {
+			count++
+		}
+	}
+	return count
+}
+
+// stripPort removes an optional port, as NAT-T addresses are shown with one.
+func stripPort(address string) string {
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		return host
+	}
+	return address
+}
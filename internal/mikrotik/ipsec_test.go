@@ -0,0 +1,35 @@
+package mikrotik
+
+import "testing"
+
+func TestIpsecCountInstalledSAs(t *testing.T) {
+	ipsec := Ipsec{
+		InstalledSAs: []IpsecInstalledSA{
+			{SrcAddress: "192.0.2.1", DstAddress: "198.51.100.1"},
+			{SrcAddress: "198.51.100.1", DstAddress: "192.0.2.1"},
+			{SrcAddress: "192.0.2.1:4500", DstAddress: "203.0.113.1:4500"},
+			{SrcAddress: "203.0.113.1:4500", DstAddress: "192.0.2.1:4500"},
+			{SrcAddress: "2001:db8::1", DstAddress: "2001:db8::2"},
+			{SrcAddress: "[2001:db8::3]:4500", DstAddress: "2001:db8::1"},
+		},
+	}
+
+	var testSuite = []struct {
+		remoteAddress string
+		out           float64
+	}{
+		{"198.51.100.1", 2},
+		{"203.0.113.1", 2},
+		{"203.0.113.1:4500", 2},
+		{"2001:db8::2", 1},
+		{"2001:db8::3", 1},
+		{"192.0.2.99", 0},
+	}
+
+	for _, test := range testSuite {
+		count := ipsec.CountInstalledSAs(IpsecPeer{RemoteAddress: test.remoteAddress})
+		if count != test.out {
+			t.Errorf("installed SAs of %q are incorrect: %v, want %v", test.remoteAddress, count, test.out)
+		}
+	}
+}
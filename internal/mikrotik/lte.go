@@ -0,0 +1,84 @@
+package mikrotik
+
+import (
+	"strconv"
+	"strings"
+)
+
+type LteInterface struct {
+	Id       string `json:".id"`
+	Name     string `json:"name"`
+	Disabled bool   `json:"disabled,string"`
+}
+
+// LteMonitor is the output of /interface/lte/monitor. Signal values are kept
+// as strings, as modems report them with or without a unit such as "dBm".
+type LteMonitor struct {
+	Interface          string `json:"-"`
+	RegistrationStatus string `json:"registration-status"`
+	CurrentOperator    string `json:"current-operator"`
+	AccessTechnology   string `json:"access-technology"`
+	PrimaryBand        string `json:"primary-band"`
+	CurrentCellid      string `json:"current-cellid"`
+	Rsrp               string `json:"rsrp"`
+	Rsrq               string `json:"rsrq"`
+	Sinr               string `json:"sinr"`
+	Rssi               string `json:"rssi"`
+	Cqi                string `json:"cqi"`
+}
+
+// LteRegistrationStatuses are the registration states that are always
+// exported, so that a change shows up as a change of value.
+var LteRegistrationStatuses = []string{"registered", "searching", "denied", "not-registered", "unknown"}
+
+// Band returns the primary band without the channel details, e.g. "B3" for
+// "B3@20Mhz earfcn: 1300 phy-cellid: 123".
+func (m *LteMonitor) Band() string {
+	band, _, _ := strings.Cut(m.PrimaryBand, "@")
+	return strings.TrimSpace(band)
+}
+
+func (c *client) GetLteMonitors() ([]LteMonitor, error) {
+	var interfaces []LteInterface
+	if err := c.getJSON("/interface/lte", &interfaces); err != nil {
+		return nil, err
+	}
+
+	var monitors []LteMonitor
+	for _, iface := range interfaces {
+		if iface.Disabled {
+			continue
+		}
+
+		body := map[string]string{
+			"numbers": iface.Name,
+			"once":    "",
+		}
+
+		// A modem that cannot be monitored, e.g. while it resets, is
+		// skipped so that the other modems are still reported.
+		var result []LteMonitor
+		if err := c.postJSON("/interface/lte/monitor", body, &result); err != nil || len(result) != 1 {
+			continue
+		}
+
+		result[0].Interface = iface.Name
+		monitors = append(monitors, result[0])
+	}
+
+	return monitors, nil
+}
+
+// ParseSignal parses a signal value such as "-95" or "-95dBm". The second
+// return value is false if the modem did not report the value.
+func ParseSignal(value string) (float64, bool) {
+	end := strings.IndexFunc(value, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.' && r != '-' && r != '+'
+	})
+	if end >= 0 {
+		value = value[:end]
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	return number, err == nil
+}
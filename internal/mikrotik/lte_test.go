@@ -0,0 +1,72 @@
+package mikrotik
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseSignal(t *testing.T) {
+	var testSuite = []struct {
+		in  string
+		out float64
+		ok  bool
+	}{
+		{"-95", -95, true},
+		{"-95dBm", -95, true},
+		{"12.5 dB", 12.5, true},
+		{"", 0, false},
+		{"n/a", 0, false},
+	}
+
+	for _, test := range testSuite {
+		value, ok := ParseSignal(test.in)
+		if ok != test.ok || value != test.out {
+			t.Errorf("signal %q is incorrect: %v %v, want %v %v", test.in, value, ok, test.out, test.ok)
+		}
+	}
+}
+
+func TestGetLteMonitorsSkipsFailingModems(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/interface/lte" {
+			json.NewEncoder(w).Encode([]interface{}{
+				map[string]interface{}{"name": "lte1", "disabled": "false"},
+				map[string]interface{}{"name": "lte2", "disabled": "false"},
+				map[string]interface{}{"name": "lte3", "disabled": "false"},
+			})
+			return
+		}
+
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		switch body["numbers"] {
+		case "lte1":
+			w.WriteHeader(http.StatusInternalServerError)
+		case "lte2":
+			json.NewEncoder(w).Encode([]interface{}{})
+		case "lte3":
+			json.NewEncoder(w).Encode([]interface{}{
+				map[string]interface{}{"registration-status": "registered", "rsrp": "-95dBm"},
+			})
+		}
+	}))
+	defer testServer.Close()
+
+	client := NewClient(Configuration{Timeout: 5, Address: testServer.URL})
+
+	monitors, err := client.GetLteMonitors()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(monitors) != 1 || monitors[0].Interface != "lte3" || monitors[0].RegistrationStatus != "registered" {
+		t.Errorf("monitors are incorrect: %+v, want only lte3", monitors)
+	}
+}
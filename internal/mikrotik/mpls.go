@@ -0,0 +1,49 @@
+package mikrotik
+
+type LdpNeighbor struct {
+	Id             string `json:".id"`
+	Peer           string `json:"peer"`
+	Transport      string `json:"transport"`
+	LocalTransport string `json:"local-transport"`
+	Operational    bool   `json:"operational,string"`
+}
+
+type BfdSession struct {
+	Id            string   `json:".id"`
+	RemoteAddress string   `json:"remote-address"`
+	LocalAddress  string   `json:"local-address"`
+	Interface     string   `json:"interface"`
+	State         string   `json:"state"`
+	Uptime        Duration `json:"uptime"`
+	Multiplier    float64  `json:"multiplier,string"`
+}
+
+// BfdStates are the states a BFD session can be in.
+var BfdStates = []string{"up", "down", "init", "admin-down"}
+
+type Mpls struct {
+	LdpNeighbors           []LdpNeighbor
+	ForwardingTableEntries float64
+	BfdSessions            []BfdSession
+}
+
+// GetMpls returns LDP neighbors, the MPLS forwarding table size and BFD
+// sessions from the RouterOS 7 paths.
+func (c *client) GetMpls() (Mpls, error) {
+	var mpls Mpls
+
+	if err := c.getJSON("/mpls/ldp/neighbor", &mpls.LdpNeighbors); err != nil {
+		return Mpls{}, err
+	}
+
+	var err error
+	if mpls.ForwardingTableEntries, err = c.count("/mpls/forwarding-table/print"); err != nil {
+		return Mpls{}, err
+	}
+
+	if err := c.getJSON("/routing/bfd/session", &mpls.BfdSessions); err != nil {
+		return Mpls{}, err
+	}
+
+	return mpls, nil
+}
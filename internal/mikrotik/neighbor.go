@@ -0,0 +1,46 @@
+package mikrotik
+
+type ArpEntry struct {
+	Interface string `json:"interface"`
+	Status    string `json:"status"`
+	Complete  bool   `json:"complete,string"`
+}
+
+// State returns the neighbor state. Older RouterOS versions only report
+// whether the entry is complete.
+func (a *ArpEntry) State() string {
+	switch {
+	case a.Status != "":
+		return a.Status
+	case a.Complete:
+		return "complete"
+	}
+	return "incomplete"
+}
+
+type Ipv6Neighbor struct {
+	Interface string `json:"interface"`
+	Status    string `json:"status"`
+}
+
+// GetArpEntries returns the ARP table reduced to the properties needed for
+// counting.
+func (c *client) GetArpEntries() ([]ArpEntry, error) {
+	var entries []ArpEntry
+	if err := c.getJSON("/ip/arp?.proplist=interface,status,complete", &entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// GetIpv6Neighbors returns the IPv6 neighbor table reduced to the properties
+// needed for counting.
+func (c *client) GetIpv6Neighbors() ([]Ipv6Neighbor, error) {
+	var neighbors []Ipv6Neighbor
+	if err := c.getJSON("/ipv6/neighbor?.proplist=interface,status", &neighbors); err != nil {
+		return nil, err
+	}
+
+	return neighbors, nil
+}
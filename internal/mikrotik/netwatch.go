@@ -0,0 +1,47 @@
+package mikrotik
+
+import "time"
+
+type Netwatch struct {
+	Id       string `json:".id"`
+	Host     string `json:"host"`
+	Type     string `json:"type"`
+	Comment  string `json:"comment"`
+	Status   string `json:"status"`
+	Since    string `json:"since"`
+	Disabled bool   `json:"disabled,string"`
+
+	// Probe results, only reported by RouterOS 7 for the respective type.
+	LossPercent    *float64  `json:"loss-percent,string"`
+	RttAvg         *Duration `json:"rtt-avg"`
+	RttMin         *Duration `json:"rtt-min"`
+	RttMax         *Duration `json:"rtt-max"`
+	RttJitter      *Duration `json:"rtt-jitter"`
+	TcpConnectTime *Duration `json:"tcp-connect-time"`
+	HttpStatusCode *float64  `json:"http-status-code,string"`
+	HttpRespTime   *Duration `json:"http-resp-time"`
+
+	LastChange time.Time `json:"-"`
+}
+
+func (n *Netwatch) IsUp() bool {
+	return n.Status == "up"
+}
+
+func (c *client) GetNetwatch() ([]Netwatch, error) {
+	clock, err := c.GetClock()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Netwatch
+	if err := c.getJSON("/tool/netwatch", &entries); err != nil {
+		return nil, err
+	}
+
+	for i := range entries {
+		entries[i].LastChange = clock.parseTimeOrZero(entries[i].Since)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,85 @@
+package mikrotik
+
+import (
+	"strconv"
+	"strings"
+)
+
+// NtpClient covers both the RouterOS 6 SNTP client and the RouterOS 7 NTP
+// client, which share the path but not the field names.
+type NtpClient struct {
+	Enabled bool   `json:"enabled,string"`
+	Mode    string `json:"mode"`
+
+	// RouterOS 7
+	Status        string `json:"status"`
+	SyncedServer  string `json:"synced-server"`
+	SyncedStratum string `json:"synced-stratum"`
+	SystemOffset  string `json:"system-offset"`
+
+	// RouterOS 6
+	LastUpdateFrom   string `json:"last-update-from"`
+	LastUpdateBefore string `json:"last-update-before"`
+	LastAdjustment   string `json:"last-adjustment"`
+}
+
+// IsSynchronized reports whether the clock is synchronized. The SNTP client
+// has no status, so any server it received an update from counts.
+func (n *NtpClient) IsSynchronized() bool {
+	if n.Status != "" {
+		return n.Status == "synchronized"
+	}
+	return n.LastUpdateFrom != ""
+}
+
+func (n *NtpClient) Server() string {
+	if n.SyncedServer != "" {
+		return n.SyncedServer
+	}
+	return n.LastUpdateFrom
+}
+
+// Offset returns the clock offset in seconds. RouterOS 7 reports it in
+// milliseconds without unit, RouterOS 6 as the last adjustment like "-12ms".
+func (n *NtpClient) Offset() (float64, bool) {
+	if n.SystemOffset != "" {
+		if offset, err := parseSignedDuration(n.SystemOffset); err == nil {
+			return offset, true
+		}
+		if offset, err := strconv.ParseFloat(strings.TrimSuffix(n.SystemOffset, " ms"), 64); err == nil {
+			return offset / 1000, true
+		}
+	}
+
+	if n.LastAdjustment != "" {
+		if offset, err := parseSignedDuration(n.LastAdjustment); err == nil {
+			return offset, true
+		}
+	}
+
+	return 0, false
+}
+
+// Stratum returns the stratum of the synchronized server, only known to the
+// RouterOS 7 client.
+func (n *NtpClient) Stratum() (float64, bool) {
+	stratum, err := strconv.ParseFloat(n.SyncedStratum, 64)
+	return stratum, err == nil
+}
+
+func parseSignedDuration(value string) (float64, error) {
+	if strings.HasPrefix(value, "-") {
+		seconds, err := ParseDuration(value[1:])
+		return -seconds, err
+	}
+	return ParseDuration(strings.TrimPrefix(value, "+"))
+}
+
+func (c *client) GetNtpClient() (NtpClient, error) {
+	var ntpClient NtpClient
+	if err := c.getJSON("/system/ntp/client", &ntpClient); err != nil {
+		return NtpClient{}, err
+	}
+
+	return ntpClient, nil
+}
@@ -0,0 +1,42 @@
+package mikrotik
+
+import "testing"
+
+func TestNtpClientOffset(t *testing.T) {
+	var testSuite = []struct {
+		in     NtpClient
+		offset float64
+		ok     bool
+	}{
+		{NtpClient{SystemOffset: "-0.5"}, -0.0005, true},
+		{NtpClient{SystemOffset: "2 ms"}, 0.002, true},
+		{NtpClient{SystemOffset: "1s500ms"}, 1.5, true},
+		{NtpClient{LastAdjustment: "-12ms"}, -0.012, true},
+		{NtpClient{}, 0, false},
+	}
+
+	for _, test := range testSuite {
+		offset, ok := test.in.Offset()
+		if ok != test.ok || offset != test.offset {
+			t.Errorf("offset of %+v is incorrect: %v %v, want %v %v", test.in, offset, ok, test.offset, test.ok)
+		}
+	}
+}
+
+func TestNtpClientIsSynchronized(t *testing.T) {
+	var testSuite = []struct {
+		in  NtpClient
+		out bool
+	}{
+		{NtpClient{Status: "synchronized"}, true},
+		{NtpClient{Status: "started"}, false},
+		{NtpClient{LastUpdateFrom: "192.0.2.1"}, true},
+		{NtpClient{}, false},
+	}
+
+	for _, test := range testSuite {
+		if synchronized := test.in.IsSynchronized(); synchronized != test.out {
+			t.Errorf("synchronized of %+v is incorrect: %v, want %v", test.in, synchronized, test.out)
+		}
+	}
+}
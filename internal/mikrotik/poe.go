@@ -0,0 +1,71 @@
+package mikrotik
+
+import "strings"
+
+type PoePort struct {
+	Name   string `json:"name"`
+	PoeOut string `json:"poe-out"`
+	Status string `json:"poe-out-status"`
+	// Voltage in V, current in mA and power in W. They are only reported
+	// while the port is powering a device.
+	Voltage *float64 `json:"poe-out-voltage,string"`
+	Current *float64 `json:"poe-out-current,string"`
+	Power   *float64 `json:"poe-out-power,string"`
+}
+
+func (p *PoePort) IsPoweredOn() bool {
+	return p.Status == "powered-on"
+}
+
+type Poe struct {
+	Ports []PoePort
+	// Consumption and Budget are the total PoE output in W from
+	// /system/health, if the device reports them.
+	Consumption *float64
+	Budget      *float64
+}
+
+func (c *client) GetPoe() (Poe, error) {
+	// Boards without PoE do not have the menu at all.
+	var ports []PoePort
+	if err := c.getJSON("/interface/ethernet/poe", &ports); err != nil && !isMissingMenu(err) {
+		return Poe{}, err
+	}
+
+	var poe Poe
+	if len(ports) > 0 {
+		names := make([]string, 0, len(ports))
+		for _, port := range ports {
+			names = append(names, port.Name)
+		}
+
+		body := map[string]string{
+			"numbers": strings.Join(names, ","),
+			"once":    "",
+		}
+		if err := c.postJSON("/interface/ethernet/poe/monitor", body, &poe.Ports); err != nil {
+			return Poe{}, err
+		}
+	}
+
+	sensors, err := c.getHealthSensors()
+	if err != nil {
+		return Poe{}, err
+	}
+
+	for _, sensor := range sensors {
+		if !sensor.Numeric {
+			continue
+		}
+
+		value := sensor.Value
+		switch sensor.Name {
+		case "poe-out-consumption":
+			poe.Consumption = &value
+		case "poe-out-budget":
+			poe.Budget = &value
+		}
+	}
+
+	return poe, nil
+}
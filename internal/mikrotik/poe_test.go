@@ -0,0 +1,51 @@
+package mikrotik
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetPoeWithoutPoeMenu(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/system/health" {
+			json.NewEncoder(w).Encode([]interface{}{
+				map[string]interface{}{"name": "temperature", "type": "C", "value": "40"},
+			})
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"error":   400,
+			"message": "Bad Request",
+			"detail":  "no such command or directory (poe)",
+		})
+	}))
+	defer testServer.Close()
+
+	client := NewClient(Configuration{Timeout: 5, Address: testServer.URL})
+
+	poe, err := client.GetPoe()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(poe.Ports) != 0 || poe.Consumption != nil || poe.Budget != nil {
+		t.Errorf("poe is incorrect: %+v, want no ports", poe)
+	}
+}
+
+func TestGetPoeFailsOnOtherErrors(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer testServer.Close()
+
+	client := NewClient(Configuration{Timeout: 5, Address: testServer.URL})
+
+	if _, err := client.GetPoe(); err == nil {
+		t.Error("unauthorized request should fail")
+	}
+}
@@ -0,0 +1,58 @@
+package mikrotik
+
+type PppActive struct {
+	Id       string   `json:".id"`
+	Name     string   `json:"name"`
+	Service  string   `json:"service"`
+	CallerId string   `json:"caller-id"`
+	Address  string   `json:"address"`
+	Uptime   Duration `json:"uptime"`
+	Radius   bool     `json:"radius,string"`
+}
+
+type PppSecret struct {
+	Name    string `json:"name"`
+	Profile string `json:"profile"`
+}
+
+type PppoeServer struct {
+	Id             string `json:".id"`
+	ServiceName    string `json:"service-name"`
+	Interface      string `json:"interface"`
+	DefaultProfile string `json:"default-profile"`
+	Disabled       bool   `json:"disabled,string"`
+}
+
+type Ppp struct {
+	Active       []PppActive
+	Secrets      []PppSecret
+	PppoeServers []PppoeServer
+}
+
+func (c *client) GetPpp() (Ppp, error) {
+	var ppp Ppp
+
+	if err := c.getJSON("/ppp/active", &ppp.Active); err != nil {
+		return Ppp{}, err
+	}
+
+	if err := c.getJSON("/ppp/secret?.proplist=name,profile", &ppp.Secrets); err != nil {
+		return Ppp{}, err
+	}
+
+	if err := c.getJSON("/interface/pppoe-server/server", &ppp.PppoeServers); err != nil {
+		return Ppp{}, err
+	}
+
+	return ppp, nil
+}
+
+// Profiles maps the name of every local secret to its profile. Sessions
+// authenticated by RADIUS have no local secret.
+func (p *Ppp) Profiles() map[string]string {
+	profiles := make(map[string]string, len(p.Secrets))
+	for _, secret := range p.Secrets {
+		profiles[secret.Name] = secret.Profile
+	}
+	return profiles
+}
@@ -0,0 +1,125 @@
+package mikrotik
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// UploadDownload is a simple queue value formatted as "upload/download".
+type UploadDownload struct {
+	Upload   float64
+	Download float64
+}
+
+func (u *UploadDownload) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	upload, download, ok := strings.Cut(value, "/")
+	if !ok {
+		return fmt.Errorf("invalid upload/download value: %q", value)
+	}
+
+	var err error
+	if u.Upload, err = parseRate(upload); err != nil {
+		return err
+	}
+	if u.Download, err = parseRate(download); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Rate is a queue value which may use a k, M or G suffix such as "10M".
+type Rate float64
+
+func (r *Rate) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	rate, err := parseRate(value)
+	if err != nil {
+		return err
+	}
+
+	*r = Rate(rate)
+	return nil
+}
+
+var rateSuffixes = map[string]float64{
+	"k": 1e3,
+	"M": 1e6,
+	"G": 1e9,
+}
+
+func parseRate(value string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	multiplier := 1.0
+	if m, ok := rateSuffixes[value[len(value)-1:]]; ok {
+		multiplier = m
+		value = value[:len(value)-1]
+	}
+
+	rate, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return rate * multiplier, nil
+}
+
+type SimpleQueue struct {
+	Id            string         `json:".id"`
+	Name          string         `json:"name"`
+	Target        string         `json:"target"`
+	Parent        string         `json:"parent"`
+	Bytes         UploadDownload `json:"bytes"`
+	Packets       UploadDownload `json:"packets"`
+	Dropped       UploadDownload `json:"dropped"`
+	QueuedBytes   UploadDownload `json:"queued-bytes"`
+	QueuedPackets UploadDownload `json:"queued-packets"`
+	MaxLimit      UploadDownload `json:"max-limit"`
+	Disabled      bool           `json:"disabled,string"`
+}
+
+type QueueTree struct {
+	Id            string  `json:".id"`
+	Name          string  `json:"name"`
+	Parent        string  `json:"parent"`
+	PacketMark    string  `json:"packet-mark"`
+	Bytes         float64 `json:"bytes,string"`
+	Packets       float64 `json:"packets,string"`
+	Dropped       float64 `json:"dropped,string"`
+	QueuedBytes   float64 `json:"queued-bytes,string"`
+	QueuedPackets float64 `json:"queued-packets,string"`
+	MaxLimit      Rate    `json:"max-limit"`
+	Disabled      bool    `json:"disabled,string"`
+}
+
+func (c *client) GetSimpleQueues() ([]SimpleQueue, error) {
+	var queues []SimpleQueue
+	if err := c.getJSON("/queue/simple", &queues); err != nil {
+		return nil, err
+	}
+
+	return queues, nil
+}
+
+func (c *client) GetQueueTree() ([]QueueTree, error) {
+	var queues []QueueTree
+	if err := c.getJSON("/queue/tree", &queues); err != nil {
+		return nil, err
+	}
+
+	return queues, nil
+}
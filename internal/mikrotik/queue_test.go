@@ -0,0 +1,35 @@
+package mikrotik
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUploadDownloadIsParsed(t *testing.T) {
+	var testSuite = []struct {
+		in       string
+		upload   float64
+		download float64
+	}{
+		{`"0/0"`, 0, 0},
+		{`"123/4567"`, 123, 4567},
+		{`"10M/20M"`, 10e6, 20e6},
+		{`"512k/1G"`, 512e3, 1e9},
+	}
+
+	for _, test := range testSuite {
+		var value UploadDownload
+		if err := json.Unmarshal([]byte(test.in), &value); err != nil {
+			t.Error(err)
+		}
+
+		if value.Upload != test.upload || value.Download != test.download {
+			t.Errorf("%s is incorrect: %v/%v, want %v/%v", test.in, value.Upload, value.Download, test.upload, test.download)
+		}
+	}
+
+	var value UploadDownload
+	if err := json.Unmarshal([]byte(`"123"`), &value); err == nil {
+		t.Error("value without a slash should be invalid")
+	}
+}
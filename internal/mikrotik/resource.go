@@ -7,6 +7,10 @@ import (
 )
 
 type Resource struct {
+	Version              string  `json:"version"`
+	BoardName            string  `json:"board-name"`
+	ArchitectureName     string  `json:"architecture-name"`
+	Uptime               string  `json:"uptime"`
 	CpuCount             float64 `json:"cpu-count,string"`
 	CpuFrequency         float64 `json:"cpu-frequency,string"`
 	CpuLoad              float64 `json:"cpu-load,string"`
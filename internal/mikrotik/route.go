@@ -0,0 +1,75 @@
+package mikrotik
+
+// RouteProtocols are the route flags counted separately. Routes matching none
+// of them are reported with the protocol "other".
+var RouteProtocols = []string{"connect", "static", "bgp", "ospf"}
+
+type RouteCount struct {
+	AddressFamily string
+	Table         string
+	Protocol      string
+	Total         float64
+	Active        float64
+}
+
+type routingTable struct {
+	Name string `json:"name"`
+}
+
+func (c *client) GetRouteCounts() ([]RouteCount, error) {
+	var tables []routingTable
+	if err := c.getJSON("/routing/table", &tables); err != nil {
+		return nil, err
+	}
+
+	families := []struct {
+		name string
+		path string
+	}{
+		{"ipv4", "/ip/route/print"},
+		{"ipv6", "/ipv6/route/print"},
+	}
+
+	var counts []RouteCount
+	for _, family := range families {
+		for _, table := range tables {
+			tableQuery := "routing-table=" + table.Name
+
+			total, err := c.count(family.path, tableQuery)
+			if err != nil {
+				return nil, err
+			}
+			totalActive, err := c.count(family.path, tableQuery, "active=true")
+			if err != nil {
+				return nil, err
+			}
+
+			for _, protocol := range RouteProtocols {
+				count := RouteCount{AddressFamily: family.name, Table: table.Name, Protocol: protocol}
+
+				count.Total, err = c.count(family.path, tableQuery, protocol+"=true")
+				if err != nil {
+					return nil, err
+				}
+				count.Active, err = c.count(family.path, tableQuery, protocol+"=true", "active=true")
+				if err != nil {
+					return nil, err
+				}
+
+				total -= count.Total
+				totalActive -= count.Active
+				counts = append(counts, count)
+			}
+
+			counts = append(counts, RouteCount{
+				AddressFamily: family.name,
+				Table:         table.Name,
+				Protocol:      "other",
+				Total:         total,
+				Active:        totalActive,
+			})
+		}
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,89 @@
+package mikrotik
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGetRouteCounts(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/routing/table" {
+			json.NewEncoder(w).Encode([]interface{}{
+				map[string]interface{}{"name": "main"},
+			})
+			return
+		}
+
+		var body struct {
+			Query []string `json:".query"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		query := strings.Join(body.Query, ",")
+		counts := map[string]string{
+			"routing-table=main":                          "10",
+			"routing-table=main,active=true":              "8",
+			"routing-table=main,bgp=true":                 "6",
+			"routing-table=main,bgp=true,active=true":     "5",
+			"routing-table=main,connect=true":             "2",
+			"routing-table=main,connect=true,active=true": "2",
+		}
+
+		ret, ok := counts[query]
+		if !ok {
+			ret = "0"
+		}
+
+		if r.URL.Path == "/rest/ipv6/route/print" {
+			json.NewEncoder(w).Encode([]interface{}{map[string]interface{}{"ret": "0"}})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"ret": ret})
+	}))
+	defer testServer.Close()
+
+	client := NewClient(Configuration{Timeout: 5, Address: testServer.URL})
+
+	counts, err := client.GetRouteCounts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var testSuite = []struct {
+		addressFamily string
+		protocol      string
+		total         float64
+		active        float64
+	}{
+		{"ipv4", "bgp", 6, 5},
+		{"ipv4", "connect", 2, 2},
+		{"ipv4", "static", 0, 0},
+		{"ipv4", "other", 2, 1},
+		{"ipv6", "other", 0, 0},
+	}
+
+	for _, test := range testSuite {
+		found := false
+		for _, count := range counts {
+			if count.AddressFamily != test.addressFamily || count.Protocol != test.protocol {
+				continue
+			}
+			found = true
+
+			if count.Total != test.total || count.Active != test.active {
+				t.Errorf("%s %s routes are incorrect: %v/%v, want %v/%v", test.addressFamily, test.protocol, count.Total, count.Active, test.total, test.active)
+			}
+		}
+
+		if !found {
+			t.Errorf("%s %s routes are missing", test.addressFamily, test.protocol)
+		}
+	}
+}
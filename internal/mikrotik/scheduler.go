@@ -0,0 +1,61 @@
+package mikrotik
+
+import "time"
+
+type Scheduler struct {
+	Id       string   `json:".id"`
+	Name     string   `json:"name"`
+	Comment  string   `json:"comment"`
+	Interval Duration `json:"interval"`
+	RunCount float64  `json:"run-count,string"`
+	NextRun  string   `json:"next-run"`
+	Disabled bool     `json:"disabled,string"`
+
+	NextRunTime time.Time `json:"-"`
+}
+
+type Script struct {
+	Id          string  `json:".id"`
+	Name        string  `json:"name"`
+	Comment     string  `json:"comment"`
+	RunCount    float64 `json:"run-count,string"`
+	LastStarted string  `json:"last-started"`
+
+	LastStartedTime time.Time `json:"-"`
+}
+
+func (c *client) GetSchedulers() ([]Scheduler, error) {
+	clock, err := c.GetClock()
+	if err != nil {
+		return nil, err
+	}
+
+	var schedulers []Scheduler
+	if err := c.getJSON("/system/scheduler", &schedulers); err != nil {
+		return nil, err
+	}
+
+	for i := range schedulers {
+		schedulers[i].NextRunTime = clock.parseTimeOrZero(schedulers[i].NextRun)
+	}
+
+	return schedulers, nil
+}
+
+func (c *client) GetScripts() ([]Script, error) {
+	clock, err := c.GetClock()
+	if err != nil {
+		return nil, err
+	}
+
+	var scripts []Script
+	if err := c.getJSON("/system/script?.proplist=.id,name,comment,run-count,last-started", &scripts); err != nil {
+		return nil, err
+	}
+
+	for i := range scripts {
+		scripts[i].LastStartedTime = clock.parseTimeOrZero(scripts[i].LastStarted)
+	}
+
+	return scripts, nil
+}
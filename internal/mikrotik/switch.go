@@ -0,0 +1,103 @@
+package mikrotik
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// SwitchPort holds the hardware counters of a switch chip port. The counters
+// differ between switch chips, so every numeric rx-/tx- property is kept
+// with the prefix removed, e.g. "bytes" or "fcs-error".
+type SwitchPort struct {
+	Name        string
+	Switch      string
+	Received    map[string]float64
+	Transferred map[string]float64
+}
+
+func (p *SwitchPort) UnmarshalJSON(data []byte) error {
+	var properties map[string]string
+	if err := json.Unmarshal(data, &properties); err != nil {
+		return err
+	}
+
+	p.Name = properties["name"]
+	p.Received = make(map[string]float64)
+	p.Transferred = make(map[string]float64)
+
+	for key, value := range properties {
+		var counters map[string]float64
+		var counter string
+		switch {
+		case strings.HasPrefix(key, "rx-"):
+			counters, counter = p.Received, strings.TrimPrefix(key, "rx-")
+		case strings.HasPrefix(key, "tx-"):
+			counters, counter = p.Transferred, strings.TrimPrefix(key, "tx-")
+		default:
+			continue
+		}
+
+		if number, err := strconv.ParseFloat(value, 64); err == nil {
+			counters[counter] = number
+		}
+	}
+
+	return nil
+}
+
+type SwitchRule struct {
+	Id       string   `json:".id"`
+	Switch   string   `json:"switch"`
+	Ports    string   `json:"ports"`
+	Comment  string   `json:"comment"`
+	Bytes    *float64 `json:"bytes,string"`
+	Packets  *float64 `json:"packets,string"`
+	Disabled bool     `json:"disabled,string"`
+}
+
+type switchPortConfig struct {
+	Name   string `json:"name"`
+	Switch string `json:"switch"`
+}
+
+// GetSwitchPorts returns the output of "print stats", as the plain port list
+// only contains the configuration. The stats do not include the switch a
+// port belongs to, so it is taken from the port list.
+func (c *client) GetSwitchPorts() ([]SwitchPort, error) {
+	var configs []switchPortConfig
+	if err := c.getJSON("/interface/ethernet/switch/port?.proplist=name,switch", &configs); err != nil {
+		return nil, err
+	}
+
+	body := map[string]string{
+		"stats": "",
+	}
+
+	var ports []SwitchPort
+	if err := c.postJSON("/interface/ethernet/switch/port/print", body, &ports); err != nil {
+		return nil, err
+	}
+
+	switches := make(map[string]string, len(configs))
+	for _, config := range configs {
+		switches[config.Name] = config.Switch
+	}
+
+	for i := range ports {
+		ports[i].Switch = switches[ports[i].Name]
+	}
+
+	return ports, nil
+}
+
+// GetSwitchRules returns the switch rules. Only switch chips that support
+// rule counters report bytes and packets.
+func (c *client) GetSwitchRules() ([]SwitchRule, error) {
+	var rules []SwitchRule
+	if err := c.getJSON("/interface/ethernet/switch/rule", &rules); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,98 @@
+package mikrotik
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSwitchPortCountersAreParsed(t *testing.T) {
+	var port SwitchPort
+	err := json.Unmarshal([]byte(`{
+		".id": "*1",
+		"name": "ether1",
+		"rx-bytes": "1000",
+		"rx-fcs-error": "2",
+		"tx-bytes": "3000",
+		"tx-flow-control": "off"
+	}`), &port)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if port.Name != "ether1" {
+		t.Errorf("port is incorrect: %+v", port)
+	}
+
+	if port.Received["bytes"] != 1000 || port.Received["fcs-error"] != 2 || len(port.Received) != 2 {
+		t.Errorf("received counters are incorrect: %v", port.Received)
+	}
+
+	if port.Transferred["bytes"] != 3000 || len(port.Transferred) != 1 {
+		t.Errorf("transferred counters are incorrect: %v", port.Transferred)
+	}
+}
+
+func TestGetSwitchPorts(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/interface/ethernet/switch/port":
+			json.NewEncoder(w).Encode([]interface{}{
+				map[string]interface{}{"name": "ether1", "switch": "switch1"},
+				map[string]interface{}{"name": "sfp-sfpplus1", "switch": "switch2"},
+			})
+
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/interface/ethernet/switch/port/print":
+			var body map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Error(err)
+			}
+			if _, ok := body["stats"]; !ok {
+				t.Errorf("print request is missing stats: %v", body)
+			}
+
+			json.NewEncoder(w).Encode([]interface{}{
+				map[string]interface{}{"name": "ether1", "rx-bytes": "1000", "tx-bytes": "2000"},
+				map[string]interface{}{"name": "sfp-sfpplus1", "rx-bytes": "3000", "tx-bytes": "4000"},
+			})
+
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer testServer.Close()
+
+	client := NewClient(Configuration{Timeout: 5, Address: testServer.URL})
+
+	ports, err := client.GetSwitchPorts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var testSuite = []struct {
+		name        string
+		switchName  string
+		received    float64
+		transferred float64
+	}{
+		{"ether1", "switch1", 1000, 2000},
+		{"sfp-sfpplus1", "switch2", 3000, 4000},
+	}
+
+	if len(ports) != len(testSuite) {
+		t.Fatalf("got %d ports, want %d", len(ports), len(testSuite))
+	}
+
+	for i, test := range testSuite {
+		port := ports[i]
+		if port.Name != test.name || port.Switch != test.switchName {
+			t.Errorf("port is incorrect: %s on %q, want %s on %q", port.Name, port.Switch, test.name, test.switchName)
+		}
+
+		if port.Received["bytes"] != test.received || port.Transferred["bytes"] != test.transferred {
+			t.Errorf("%s counters are incorrect: %v/%v", port.Name, port.Received, port.Transferred)
+		}
+	}
+}
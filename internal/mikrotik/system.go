@@ -0,0 +1,34 @@
+package mikrotik
+
+type Identity struct {
+	Name string `json:"name"`
+}
+
+type Routerboard struct {
+	Routerboard     bool   `json:"routerboard,string"`
+	Model           string `json:"model"`
+	SerialNumber    string `json:"serial-number"`
+	FirmwareType    string `json:"firmware-type"`
+	CurrentFirmware string `json:"current-firmware"`
+	UpgradeFirmware string `json:"upgrade-firmware"`
+}
+
+func (c *client) GetIdentity() (Identity, error) {
+	var identity Identity
+	if err := c.getJSON("/system/identity", &identity); err != nil {
+		return Identity{}, err
+	}
+
+	return identity, nil
+}
+
+// GetRouterboard returns the RouterBOARD details. On CHR and x86 installs
+// Routerboard is false and the other fields are empty.
+func (c *client) GetRouterboard() (Routerboard, error) {
+	var routerboard Routerboard
+	if err := c.getJSON("/system/routerboard", &routerboard); err != nil {
+		return Routerboard{}, err
+	}
+
+	return routerboard, nil
+}
@@ -0,0 +1,128 @@
+package mikrotik
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type PackageUpdate struct {
+	Channel          string `json:"channel"`
+	InstalledVersion string `json:"installed-version"`
+	LatestVersion    string `json:"latest-version"`
+	Status           string `json:"status"`
+}
+
+// IsUpgradeAvailable reports whether a newer version is known. LatestVersion
+// is only set once the router has checked for updates, and is older than the
+// installed version after switching to a more conservative channel. Versions
+// that cannot be compared fall back to the status reported by the router.
+func (p *PackageUpdate) IsUpgradeAvailable() bool {
+	if p.LatestVersion == "" {
+		return false
+	}
+
+	if newer, err := isNewerVersion(p.LatestVersion, p.InstalledVersion); err == nil {
+		return newer
+	}
+
+	return p.Status == "New version is available"
+}
+
+// IsUpgradeAvailable reports whether the RouterBOOT firmware is older than the
+// one shipped with the installed RouterOS version.
+func (r *Routerboard) IsUpgradeAvailable() bool {
+	return r.Routerboard && r.UpgradeFirmware != "" && r.UpgradeFirmware != r.CurrentFirmware
+}
+
+// GetPackageUpdate returns the update status as last checked by the router.
+// If checkForUpdates is set, the router contacts the MikroTik servers first.
+func (c *client) GetPackageUpdate(checkForUpdates bool) (PackageUpdate, error) {
+	if checkForUpdates {
+		var progress []PackageUpdate
+		if err := c.postJSON("/system/package/update/check-for-updates", map[string]string{}, &progress); err != nil {
+			return PackageUpdate{}, err
+		}
+	}
+
+	var update PackageUpdate
+	if err := c.getJSON("/system/package/update", &update); err != nil {
+		return PackageUpdate{}, err
+	}
+
+	return update, nil
+}
+
+var versionPattern = regexp.MustCompile(`^(\d+(?:\.\d+)*)(?:(alpha|beta|rc)(\d+))?$`)
+
+// prereleaseRanks orders the pre-releases of a version before the release,
+// which has no suffix.
+var prereleaseRanks = map[string]int{
+	"alpha": 0,
+	"beta":  1,
+	"rc":    2,
+	"":      3,
+}
+
+type version struct {
+	numbers    []int
+	prerelease int
+	number     int
+}
+
+// isNewerVersion reports whether the RouterOS version a, e.g. "7.12rc1", is
+// newer than b.
+func isNewerVersion(a, b string) (bool, error) {
+	x, err := parseVersion(a)
+	if err != nil {
+		return false, err
+	}
+
+	y, err := parseVersion(b)
+	if err != nil {
+		return false, err
+	}
+
+	for i := 0; i < len(x.numbers) || i < len(y.numbers); i++ {
+		var u, v int
+		if i < len(x.numbers) {
+			u = x.numbers[i]
+		}
+		if i < len(y.numbers) {
+			v = y.numbers[i]
+		}
+		if u != v {
+			return u > v, nil
+		}
+	}
+
+	if x.prerelease != y.prerelease {
+		return x.prerelease > y.prerelease, nil
+	}
+
+	return x.number > y.number, nil
+}
+
+func parseVersion(value string) (version, error) {
+	match := versionPattern.FindStringSubmatch(value)
+	if match == nil {
+		return version{}, fmt.Errorf("invalid version: %q", value)
+	}
+
+	var v version
+	for _, part := range strings.Split(match[1], ".") {
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			return version{}, err
+		}
+		v.numbers = append(v.numbers, number)
+	}
+
+	v.prerelease = prereleaseRanks[match[2]]
+	if match[3] != "" {
+		v.number, _ = strconv.Atoi(match[3])
+	}
+
+	return v, nil
+}
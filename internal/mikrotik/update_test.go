@@ -0,0 +1,33 @@
+package mikrotik
+
+import "testing"
+
+func TestPackageUpdateIsUpgradeAvailable(t *testing.T) {
+	var testSuite = []struct {
+		installed string
+		latest    string
+		status    string
+		out       bool
+	}{
+		{"7.11.2", "", "", false},
+		{"7.11.2", "7.11.2", "System is already up to date", false},
+		{"7.11.2", "7.12", "New version is available", true},
+		{"7.12", "7.11.2", "System is already up to date", false},
+		{"7.12beta3", "7.11.2", "System is already up to date", false},
+		{"7.12beta3", "7.12rc1", "New version is available", true},
+		{"7.12rc1", "7.12", "New version is available", true},
+		{"7.12", "7.12rc1", "System is already up to date", false},
+		{"6.49.10", "6.49.9", "System is already up to date", false},
+		{"6.49.9", "6.49.10", "New version is available", true},
+		{"7.12", "7.12.1", "New version is available", true},
+		{"7.12", "7.x", "New version is available", true},
+		{"7.12", "7.x", "System is already up to date", false},
+	}
+
+	for _, test := range testSuite {
+		update := PackageUpdate{InstalledVersion: test.installed, LatestVersion: test.latest, Status: test.status}
+		if available := update.IsUpgradeAvailable(); available != test.out {
+			t.Errorf("upgrade from %q to %q is incorrect: %v, want %v", test.installed, test.latest, available, test.out)
+		}
+	}
+}
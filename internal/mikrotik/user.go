@@ -0,0 +1,19 @@
+package mikrotik
+
+type ActiveUser struct {
+	Id      string `json:".id"`
+	Name    string `json:"name"`
+	Group   string `json:"group"`
+	Via     string `json:"via"`
+	Address string `json:"address"`
+	When    string `json:"when"`
+}
+
+func (c *client) GetActiveUsers() ([]ActiveUser, error) {
+	var users []ActiveUser
+	if err := c.getJSON("/user/active", &users); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
@@ -0,0 +1,41 @@
+package mikrotik
+
+type Vrrp struct {
+	Id        string  `json:".id"`
+	Name      string  `json:"name"`
+	Interface string  `json:"interface"`
+	Vrid      string  `json:"vrid"`
+	Priority  float64 `json:"priority,string"`
+	Master    bool    `json:"master,string"`
+	Backup    bool    `json:"backup,string"`
+	Owner     bool    `json:"owner,string"`
+	Disabled  bool    `json:"disabled,string"`
+}
+
+// VrrpStates are the states a VRRP instance can be in.
+var VrrpStates = []string{"master", "backup", "init"}
+
+func (v *Vrrp) State() string {
+	switch {
+	case v.Master:
+		return "master"
+	case v.Backup:
+		return "backup"
+	}
+	return "init"
+}
+
+// IsOwner reports whether the router owns the virtual address, which
+// RouterOS signals with the maximum priority.
+func (v *Vrrp) IsOwner() bool {
+	return v.Owner || v.Priority == 255
+}
+
+func (c *client) GetVrrps() ([]Vrrp, error) {
+	var vrrps []Vrrp
+	if err := c.getJSON("/interface/vrrp", &vrrps); err != nil {
+		return nil, err
+	}
+
+	return vrrps, nil
+}
@@ -0,0 +1,55 @@
+package mikrotik
+
+import "math"
+
+type WireguardPeer struct {
+	Id                     string    `json:".id"`
+	Interface              string    `json:"interface"`
+	Comment                string    `json:"comment"`
+	PublicKey              string    `json:"public-key"`
+	EndpointAddress        string    `json:"endpoint-address"`
+	EndpointPort           string    `json:"endpoint-port"`
+	CurrentEndpointAddress string    `json:"current-endpoint-address"`
+	CurrentEndpointPort    string    `json:"current-endpoint-port"`
+	AllowedAddress         string    `json:"allowed-address"`
+	LastHandshake          *Duration `json:"last-handshake"`
+	Rx                     float64   `json:"rx,string"`
+	Tx                     float64   `json:"tx,string"`
+	Disabled               bool      `json:"disabled,string"`
+}
+
+// Fingerprint returns a short prefix of the public key, which is enough to
+// tell peers apart without putting the whole key into every series.
+func (p *WireguardPeer) Fingerprint() string {
+	if len(p.PublicKey) > 8 {
+		return p.PublicKey[:8]
+	}
+	return p.PublicKey
+}
+
+// Endpoint returns the address and port the peer is currently reachable at,
+// falling back to the configured endpoint.
+func (p *WireguardPeer) Endpoint() (string, string) {
+	if p.CurrentEndpointAddress != "" {
+		return p.CurrentEndpointAddress, p.CurrentEndpointPort
+	}
+	return p.EndpointAddress, p.EndpointPort
+}
+
+// LastHandshakeAge returns the seconds since the last handshake, or +Inf if
+// the peer never completed one.
+func (p *WireguardPeer) LastHandshakeAge() float64 {
+	if p.LastHandshake == nil {
+		return math.Inf(1)
+	}
+	return float64(*p.LastHandshake)
+}
+
+func (c *client) GetWireguardPeers() ([]WireguardPeer, error) {
+	var peers []WireguardPeer
+	if err := c.getJSON("/interface/wireguard/peers", &peers); err != nil {
+		return nil, err
+	}
+
+	return peers, nil
+}
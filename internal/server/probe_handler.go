@@ -50,7 +50,7 @@ func (s *server) probeHandler() http.HandlerFunc {
 			Password:      credential.Password,
 		})
 
-		registry, err := metrics.CreateRegistryWithMetrics(client)
+		registry, err := metrics.CreateRegistryWithMetrics(client, s.config.Collectors)
 		if err != nil {
 			log.WithFields(log.Fields{
 				"target":     target,
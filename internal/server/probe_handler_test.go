@@ -78,36 +78,7 @@ func TestTimeoutIsSetCorrectly(t *testing.T) {
 }
 
 func TestSkipTLSVerifyHTTPHeader_SetTrue(t *testing.T) {
-	testServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path == "/rest/system/health" {
-			json.NewEncoder(w).Encode([]interface{}{
-				map[string]interface{}{
-					".id":   "*E",
-					"name":  "temperature",
-					"type":  "C",
-					"value": "49",
-				},
-			})
-		}
-
-		if r.URL.Path == "/rest/system/resource" {
-			json.NewEncoder(w).Encode(map[string]interface{}{
-				"cpu-count": "4",
-			})
-		}
-
-		if r.URL.Path == "/rest/interface" {
-			json.NewEncoder(w).Encode([]interface{}{
-				map[string]interface{}{
-					"name":     "ether1",
-					"tx-byte":  "123",
-					"type":     "ether",
-					"disabled": "false",
-					"running":  "true",
-				},
-			})
-		}
-	}))
+	testServer := httptest.NewTLSServer(baseMetricsHandler())
 
 	defer testServer.Close()
 
@@ -135,10 +106,22 @@ func TestSkipTLSVerifyHTTPHeader_SetTrue(t *testing.T) {
 		t.Errorf("probe request handler returned wrong status code: %s, want %s", body, "mikrotik_system_health_temperature 49")
 	}
 
+	if !strings.Contains(string(body), "mikrotik_system_health_sensor{name=\"temperature\",unit=\"C\"} 49") {
+		t.Errorf("probe request handler returned wrong status code: %s, want %s", body, "mikrotik_system_health_sensor 49")
+	}
+
 	if !strings.Contains(string(body), "mikrotik_system_resource_cpu_count 4") {
 		t.Errorf("probe request handler returned wrong status code: %s, want %s", body, "mikrotik_system_resource_cpu_count 4")
 	}
 
+	if !strings.Contains(string(body), "mikrotik_system_uptime_seconds 93784") {
+		t.Errorf("probe request handler returned wrong status code: %s, want %s", body, "mikrotik_system_uptime_seconds 93784")
+	}
+
+	if !strings.Contains(string(body), "mikrotik_system_info{architecture=\"\",board_name=\"\",firmware=\"\",identity=\"router1\",model=\"RB5009UG+S+\",serial_number=\"\",version=\"7.11.2 (stable)\"} 1") {
+		t.Errorf("probe request handler returned wrong status code: %s, want %s", body, "mikrotik_system_info 1")
+	}
+
 	if !strings.Contains(string(body), "mikrotik_interface_transferred_bytes{name=\"ether1\",type=\"ether\"}") {
 		t.Errorf("probe request handler returned wrong status code: %s, want %s", body, "mikrotik_interface_transferred_bytes 123")
 	}
@@ -171,3 +154,152 @@ func TestSkipTLSVerifyHTTPHeader_SetFalse(t *testing.T) {
 		t.Errorf("probe request handler returned wrong status code: %s, want %s", body, "mikrotik_probe_success 0")
 	}
 }
+
+func TestFailingCollectorKeepsProbe(t *testing.T) {
+	baseHandler := baseMetricsHandler()
+	testServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/interface/ethernet/poe" {
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"error":   500,
+				"message": "Internal Server Error",
+			})
+			return
+		}
+
+		baseHandler.ServeHTTP(w, r)
+	}))
+
+	defer testServer.Close()
+
+	url := fmt.Sprintf("/probe?target=%s&credential=default&skip_tls_verify=true", testServer.URL)
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorder := httptest.NewRecorder()
+	server := NewServer(config.Configuration{
+		Credentials: map[string]config.Credential{
+			"default": {},
+		},
+		Collectors: config.Collectors{
+			Poe: config.Collector{Enabled: true},
+		},
+	})
+
+	server.ServeHTTP(recorder, request)
+
+	body, err := io.ReadAll(recorder.Body)
+	if !strings.Contains(string(body), "mikrotik_probe_success 1") {
+		t.Errorf("probe request handler returned wrong probe status: %s, want %s", body, "mikrotik_probe_success 1")
+	}
+
+	if !strings.Contains(string(body), "mikrotik_collector_success{collector=\"poe\"} 0") {
+		t.Errorf("probe request handler returned wrong collector status: %s, want %s", body, "mikrotik_collector_success 0")
+	}
+
+	if !strings.Contains(string(body), "mikrotik_system_resource_cpu_count 4") {
+		t.Errorf("probe request handler dropped the resource metrics: %s", body)
+	}
+}
+
+func TestFailingSystemInfoKeepsProbe(t *testing.T) {
+	baseHandler := baseMetricsHandler()
+	testServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/system/identity" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if r.URL.Path == "/rest/system/resource" {
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"cpu-count": "4",
+				"uptime":    "1x",
+			})
+			return
+		}
+
+		baseHandler.ServeHTTP(w, r)
+	}))
+
+	defer testServer.Close()
+
+	url := fmt.Sprintf("/probe?target=%s&credential=default&skip_tls_verify=true", testServer.URL)
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorder := httptest.NewRecorder()
+	server := NewServer(config.Configuration{
+		Credentials: map[string]config.Credential{
+			"default": {},
+		},
+	})
+
+	server.ServeHTTP(recorder, request)
+
+	body, err := io.ReadAll(recorder.Body)
+	if !strings.Contains(string(body), "mikrotik_probe_success 1") {
+		t.Errorf("probe request handler returned wrong probe status: %s, want %s", body, "mikrotik_probe_success 1")
+	}
+
+	if !strings.Contains(string(body), "mikrotik_system_resource_cpu_count 4") {
+		t.Errorf("probe request handler dropped the resource metrics: %s", body)
+	}
+
+	if strings.Contains(string(body), "mikrotik_system_uptime_seconds") || strings.Contains(string(body), "mikrotik_system_info") {
+		t.Errorf("probe request handler exported the unavailable uptime or system info: %s", body)
+	}
+}
+
+// baseMetricsHandler answers the queries of the health, interface and
+// resource metrics, which every probe runs.
+func baseMetricsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/system/health" {
+			json.NewEncoder(w).Encode([]interface{}{
+				map[string]interface{}{
+					".id":   "*E",
+					"name":  "temperature",
+					"type":  "C",
+					"value": "49",
+				},
+			})
+		}
+
+		if r.URL.Path == "/rest/system/resource" {
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"cpu-count": "4",
+				"uptime":    "1d2h3m4s",
+				"version":   "7.11.2 (stable)",
+			})
+		}
+
+		if r.URL.Path == "/rest/system/identity" {
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"name": "router1",
+			})
+		}
+
+		if r.URL.Path == "/rest/system/routerboard" {
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"routerboard": "true",
+				"model":       "RB5009UG+S+",
+			})
+		}
+
+		if r.URL.Path == "/rest/interface" {
+			json.NewEncoder(w).Encode([]interface{}{
+				map[string]interface{}{
+					"name":     "ether1",
+					"tx-byte":  "123",
+					"type":     "ether",
+					"disabled": "false",
+					"running":  "true",
+				},
+			})
+		}
+	})
+}